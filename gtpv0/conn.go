@@ -0,0 +1,502 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv0
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv0/ie"
+	"github.com/wmnsk/go-gtp/gtpv0/message"
+)
+
+type tpduSet struct {
+	raddr   net.Addr
+	tid     uint64
+	label   uint16
+	payload []byte
+}
+
+// Conn represents a GTPv0 connection.
+//
+// As GTPv0 uses the same UDP port for both signalling and user traffic, Conn
+// handles both of them.
+type Conn struct {
+	mu      sync.Mutex
+	laddr   net.Addr
+	pktConn net.PacketConn
+	*msgHandlerMap
+	*tidPDPContextMap
+
+	tpduCh  chan *tpduSet
+	closeCh chan struct{}
+
+	// sequence is the last SequenceNumber used in the request.
+	sequence uint16
+
+	// RestartCounter is the RestartCounter value in Recovery IE, which represents how many
+	// times the GTPv0 endpoint is restarted.
+	RestartCounter uint8
+}
+
+// NewConn creates a new Conn used for server. On client side, use Dial instead.
+func NewConn(laddr net.Addr, counter uint8) *Conn {
+	return &Conn{
+		mu:               sync.Mutex{},
+		laddr:            laddr,
+		msgHandlerMap:    newDefaultMsgHandlerMap(),
+		tidPDPContextMap: newtidPDPContextMap(),
+		tpduCh:           make(chan *tpduSet),
+		closeCh:          make(chan struct{}),
+		RestartCounter:   counter,
+	}
+}
+
+// Dial sends Echo Request to raddr to check if the endpoint is alive and returns Conn.
+//
+// It does not bind the raddr to the underlying connection, which enables a Conn to
+// send to/receive from multiple peers with single laddr.
+//
+// If Echo exchange is unnecessary, use NewConn and ListenAndServe instead.
+func Dial(ctx context.Context, laddr, raddr net.Addr, counter uint8) (*Conn, error) {
+	c := NewConn(laddr, counter)
+
+	// setup underlying connection first.
+	var err error
+	c.pktConn, err = net.ListenPacket(c.laddr.Network(), c.laddr.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// send EchoRequest to raddr.
+	if _, err := c.EchoRequest(raddr); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 1500)
+
+	// if no response coming within 3 seconds, returns error without retrying.
+	if err := c.pktConn.SetReadDeadline(time.Now().Add(3 * time.Second)); err != nil {
+		return nil, err
+	}
+	n, raddr, err := c.pktConn.ReadFrom(buf)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.pktConn.SetReadDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+
+	// decode incoming message and let it be handled by default handler funcs.
+	msg, err := message.Parse(buf[:n])
+	if err != nil {
+		return nil, err
+	}
+	if err := c.handleMessage(raddr, msg); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err := c.serve(ctx); err != nil {
+			logf("fatal error on Conn %s: %s", c.LocalAddr(), err)
+		}
+	}()
+	return c, nil
+}
+
+// ListenAndServe creates a new GTPv0 *Conn and start serving.
+// This blocks, and returns error only if it face the fatal one. Non-fatal errors are logged
+// with logger. See SetLogger/EnableLogger/DisableLogger for handling of those logs.
+func (c *Conn) ListenAndServe(ctx context.Context) error {
+	if c.pktConn == nil {
+		var err error
+		c.mu.Lock()
+		c.pktConn, err = net.ListenPacket(c.laddr.Network(), c.laddr.String())
+		c.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.serve(ctx)
+}
+
+func (c *Conn) serve(ctx context.Context) error {
+	go func() {
+		select { // ctx is canceled or Close() is called
+		case <-ctx.Done():
+		case <-c.closed():
+		}
+
+		if err := c.pktConn.Close(); err != nil {
+			logf("error closing the underlying conn: %s", err)
+		}
+	}()
+
+	buf := make([]byte, 1500)
+	for {
+		n, raddr, err := c.pktConn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			// TODO: Use net.ErrClosed instead (available from Go 1.16).
+			// https://github.com/golang/go/commit/e9ad52e46dee4b4f9c73ff44f44e1e234815800f
+			if strings.Contains(err.Error(), "use of closed network connection") {
+				return nil
+			}
+			return fmt.Errorf("error reading from Conn %s: %w", c.LocalAddr(), err)
+		}
+
+		raw := make([]byte, n)
+		copy(raw, buf)
+		go func() {
+			msg, err := message.Parse(raw)
+			if err != nil {
+				logf("error parsing the message: %v, %x", err, raw)
+				return
+			}
+
+			if err := c.handleMessage(raddr, msg); err != nil {
+				logf("error handling message on Conn %s: %v", c.LocalAddr(), err)
+			}
+		}()
+	}
+}
+
+// ReadFrom reads a packet from the connection,
+// copying the payload into p. It returns the number of
+// bytes copied into p and the return address that
+// was on the packet.
+// It returns the number of bytes read (0 <= n <= len(p))
+// and any error encountered. Callers should always process
+// the n > 0 bytes returned before considering the error err.
+// ReadFrom can be made to time out and return
+// an Error with Timeout() == true after a fixed time limit;
+// see SetDeadline and SetReadDeadline.
+func (c *Conn) ReadFrom(p []byte) (n int, addr net.Addr, err error) {
+	return c.pktConn.ReadFrom(p)
+}
+
+// ReadFromGTP reads a T-PDU from the connection, copying the payload without
+// GTP header into p. It returns the number of bytes copied into p, the return
+// address that was on the packet, TID and Flow Label in the GTP header.
+func (c *Conn) ReadFromGTP(p []byte) (n int, addr net.Addr, tid uint64, label uint16, err error) {
+	select {
+	case <-c.closed():
+		err = ErrConnNotOpened
+		return
+	case tpdu := <-c.tpduCh:
+		n = copy(p, tpdu.payload)
+		addr = tpdu.raddr
+		tid = tpdu.tid
+		label = tpdu.label
+		return
+	}
+}
+
+// WriteTo writes a packet with payload p to addr.
+// WriteTo can be made to time out and return
+// an Error with Timeout() == true after a fixed time limit;
+// see SetDeadline and SetWriteDeadline.
+// On packet-oriented connections, write timeouts are rare.
+func (c *Conn) WriteTo(p []byte, addr net.Addr) (n int, err error) {
+	return c.pktConn.WriteTo(p, addr)
+}
+
+// WriteToGTP writes a T-PDU with TID, Flow Label and payload to addr.
+//
+// The Sequence Number in the header is always 0, as the sequence of T-PDU is
+// meaningful only if the reordering is required in the PDP Context.
+func (c *Conn) WriteToGTP(tid uint64, label uint16, p []byte, addr net.Addr) (n int, err error) {
+	b, err := message.NewTPDU(0, label, tid, p).Marshal()
+	if err != nil {
+		return
+	}
+
+	if _, err = c.pktConn.WriteTo(b, addr); err != nil {
+		return
+	}
+	return len(b), nil
+}
+
+// closed would be used in multiple goroutines.
+// never send struct{}{} to it; instead, use close(c.closeCh).
+func (c *Conn) closed() <-chan struct{} {
+	return c.closeCh
+}
+
+// Close closes the connection.
+// Any blocked Read or Write operations will be unblocked and return errors.
+func (c *Conn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	close(c.closeCh)
+
+	return nil
+}
+
+// LocalAddr returns the local network address.
+func (c *Conn) LocalAddr() net.Addr {
+	return c.pktConn.LocalAddr()
+}
+
+// SetDeadline sets the read and write deadlines associated
+// with the connection. It is equivalent to calling both
+// SetReadDeadline and SetWriteDeadline.
+//
+// A deadline is an absolute time after which I/O operations
+// fail with a timeout (see type Error) instead of
+// blocking. The deadline applies to all future and pending
+// I/O, not just the immediately following call to Read or
+// Write. After a deadline has been exceeded, the connection
+// can be refreshed by setting a deadline in the future.
+//
+// An idle timeout can be implemented by repeatedly extending
+// the deadline after successful Read or Write calls.
+//
+// A zero value for t means I/O operations will not time out.
+func (c *Conn) SetDeadline(t time.Time) error {
+	return c.pktConn.SetDeadline(t)
+}
+
+// SetReadDeadline sets the deadline for future Read calls
+// and any currently-blocked Read call.
+// A zero value for t means Read will not time out.
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	return c.pktConn.SetReadDeadline(t)
+}
+
+// SetWriteDeadline sets the deadline for future Write calls
+// and any currently-blocked Write call.
+// Even if write times out, it may return n > 0, indicating that
+// some of the data was successfully written.
+// A zero value for t means Write will not time out.
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	return c.pktConn.SetWriteDeadline(t)
+}
+
+// AddHandler adds a message handler to *Conn.
+//
+// By adding HandlerFuncs, *Conn will handle the specified type of message with
+// it's paired HandlerFunc when receiving.
+// Messages without registered handlers are just ignored and discarded and the user will
+// get HandlerNotFoundError error.
+//
+// HandlerFuncs for EchoRequest, EchoResponse and T-PDU are registered by default.
+// These HandlerFuncs can be overwritten by specifying message.MsgTypeEchoRequest,
+// message.MsgTypeEchoResponse and/or message.MsgTypeTPDU as msgType parameter.
+func (c *Conn) AddHandler(msgType uint8, fn HandlerFunc) {
+	c.msgHandlerMap.store(msgType, fn)
+}
+
+// AddHandlers adds multiple handler funcs at a time.
+//
+// See AddHandler for detailed usage.
+func (c *Conn) AddHandlers(funcs map[uint8]HandlerFunc) {
+	for msgType, fn := range funcs {
+		c.msgHandlerMap.store(msgType, fn)
+	}
+}
+
+func (c *Conn) handleMessage(senderAddr net.Addr, msg message.Message) error {
+	handle, ok := c.msgHandlerMap.load(msg.MessageType())
+	if !ok {
+		return &HandlerNotFoundError{MsgType: msg.MessageTypeName()}
+	}
+
+	if err := handle(c, senderAddr, msg); err != nil {
+		return fmt.Errorf("failed to handle %s: %w", msg.MessageTypeName(), err)
+	}
+
+	return nil
+}
+
+// SendMessageTo sends a message to addr.
+// Unlike WriteTo, it sets the Sequence Number properly and returns the one
+// used in the message.
+func (c *Conn) SendMessageTo(msg message.Message, addr net.Addr) (uint16, error) {
+	seq := c.IncSequence()
+	msg.SetSequenceNumber(seq)
+
+	payload, err := message.Marshal(msg)
+	if err != nil {
+		msg.SetSequenceNumber(c.DecSequence())
+		return 0, fmt.Errorf("failed to send %T: %w", msg, err)
+	}
+
+	if _, err := c.WriteTo(payload, addr); err != nil {
+		msg.SetSequenceNumber(c.DecSequence())
+		return 0, fmt.Errorf("failed to send %T: %w", msg, err)
+	}
+	return seq, nil
+}
+
+// IncSequence increments the SequenceNumber associated with Conn.
+func (c *Conn) IncSequence() uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// SequenceNumber is 2-octet long and wraps around to 0.
+	c.sequence++
+	return c.sequence
+}
+
+// DecSequence decrements the SequenceNumber associated with Conn.
+func (c *Conn) DecSequence() uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sequence--
+
+	return c.sequence
+}
+
+// SequenceNumber returns the current(=last used) SequenceNumber associated with Conn.
+func (c *Conn) SequenceNumber() uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.sequence
+}
+
+// EchoRequest sends a EchoRequest.
+func (c *Conn) EchoRequest(raddr net.Addr) (uint16, error) {
+	return c.SendMessageTo(message.NewEchoRequest(0, 0, 0), raddr)
+}
+
+// EchoResponse sends a EchoResponse in response to the EchoRequest.
+func (c *Conn) EchoResponse(raddr net.Addr, req message.Message) error {
+	return c.RespondTo(raddr, req, message.NewEchoResponse(0, 0, 0, ie.NewRecovery(c.RestartCounter)))
+}
+
+// RespondTo sends a message(specified with "toBeSent" param) in response to
+// a message(specified with "received" param).
+//
+// This is to make it easier to handle SequenceNumber.
+func (c *Conn) RespondTo(raddr net.Addr, received, toBeSent message.Message) error {
+	toBeSent.SetSequenceNumber(received.Sequence())
+	b := make([]byte, toBeSent.MarshalLen())
+	if err := toBeSent.MarshalTo(b); err != nil {
+		return err
+	}
+
+	if _, err := c.WriteTo(b, raddr); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreatePDPContext sends a CreatePDPContextRequest with the TID built from IMSI and
+// NSAPI, and registers the PDPContext returned to Conn.
+//
+// The Flow Labels in Flow Label Data I and Flow Label Signalling IEs given are
+// stored in the PDPContext as the ones allocated by this node.
+func (c *Conn) CreatePDPContext(raddr net.Addr, imsi string, nsapi uint8, ies ...*ie.IE) (*PDPContext, uint16, error) {
+	tid, err := NewTID(imsi, nsapi)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	pdp := NewPDPContext(raddr, imsi, nsapi)
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.FlowLabelDataI:
+			pdp.LocalFlowLabelData, err = i.FlowLabelDataI()
+			if err != nil {
+				return nil, 0, err
+			}
+		case ie.FlowLabelSignalling:
+			pdp.LocalFlowLabelSignalling, err = i.FlowLabelSignalling()
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+	c.RegisterPDPContext(pdp)
+
+	seq, err := c.SendMessageTo(message.NewCreatePDPContextRequest(0, 0, tid, ies...), raddr)
+	if err != nil {
+		c.RemovePDPContext(pdp)
+		return nil, 0, err
+	}
+	return pdp, seq, nil
+}
+
+// UpdatePDPContext sends an UpdatePDPContextRequest with IEs given to the peer of
+// the PDPContext.
+func (c *Conn) UpdatePDPContext(pdp *PDPContext, ies ...*ie.IE) (uint16, error) {
+	tid, err := pdp.TID()
+	if err != nil {
+		return 0, err
+	}
+
+	msg := message.NewUpdatePDPContextRequest(0, pdp.RemoteFlowLabelSignalling, tid, ies...)
+	return c.SendMessageTo(msg, pdp.PeerAddr())
+}
+
+// DeletePDPContext sends a DeletePDPContextRequest with IEs given to the peer of
+// the PDPContext.
+//
+// The PDPContext is not removed from Conn by this method, as it is still needed
+// to handle the response. Call RemovePDPContext when the response comes.
+func (c *Conn) DeletePDPContext(pdp *PDPContext, ies ...*ie.IE) (uint16, error) {
+	tid, err := pdp.TID()
+	if err != nil {
+		return 0, err
+	}
+
+	msg := message.NewDeletePDPContextRequest(0, pdp.RemoteFlowLabelSignalling, tid, ies...)
+	return c.SendMessageTo(msg, pdp.PeerAddr())
+}
+
+// GetPDPContextByTID returns PDPContext looked up by TID in the human-readable
+// string, which is the one returned by TID method of message.Message.
+func (c *Conn) GetPDPContextByTID(tid string) (*PDPContext, error) {
+	imsi, nsapi, err := ParseTID(tid)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetPDPContextByIMSIAndNSAPI(imsi, nsapi)
+}
+
+// GetPDPContextByIMSIAndNSAPI returns PDPContext looked up by IMSI and NSAPI.
+func (c *Conn) GetPDPContextByIMSIAndNSAPI(imsi string, nsapi uint8) (*PDPContext, error) {
+	if pdp, ok := c.tidPDPContextMap.load(imsi, nsapi); ok {
+		return pdp, nil
+	}
+	return nil, &PDPContextNotFoundError{IMSI: imsi, NSAPI: nsapi}
+}
+
+// RegisterPDPContext registers PDPContext to Conn with its IMSI and NSAPI(=TID).
+func (c *Conn) RegisterPDPContext(pdp *PDPContext) {
+	c.tidPDPContextMap.store(pdp.IMSI, pdp.NSAPI, pdp)
+}
+
+// RemovePDPContext removes a PDPContext registered in Conn.
+func (c *Conn) RemovePDPContext(pdp *PDPContext) {
+	c.tidPDPContextMap.delete(pdp.IMSI, pdp.NSAPI)
+}
+
+// PDPContexts returns all the PDPContexts registered in Conn.
+func (c *Conn) PDPContexts() []*PDPContext {
+	var pdps []*PDPContext
+	c.tidPDPContextMap.rangeWithFunc(func(k, v interface{}) bool {
+		pdps = append(pdps, v.(*PDPContext))
+		return true
+	})
+
+	return pdps
+}
@@ -0,0 +1,170 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv0_test
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv0"
+	"github.com/wmnsk/go-gtp/gtpv0/ie"
+	"github.com/wmnsk/go-gtp/gtpv0/message"
+)
+
+func TestTID(t *testing.T) {
+	tid, err := gtpv0.NewTID("123456789012345", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tid != 0x2143658709214355 {
+		t.Errorf("wrong TID. want %#016x, got: %#016x", uint64(0x2143658709214355), tid)
+	}
+
+	msg := message.NewEchoRequest(0, 0, tid)
+	imsi, nsapi, err := gtpv0.ParseTID(msg.TID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imsi != "123456789012345" || nsapi != 5 {
+		t.Errorf("wrong IMSI/NSAPI. want %s/%d, got: %s/%d", "123456789012345", 5, imsi, nsapi)
+	}
+}
+
+func TestConn(t *testing.T) {
+	sgsnAddr, err := net.ResolveUDPAddr("udp", "127.0.0.1"+gtpv0.GTPPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ggsnAddr, err := net.ResolveUDPAddr("udp", "127.0.0.2"+gtpv0.GTPPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ggsnConn := gtpv0.NewConn(ggsnAddr, 0)
+	ggsnConn.AddHandler(message.MsgTypeCreatePDPContextRequest, func(c *gtpv0.Conn, senderAddr net.Addr, msg message.Message) error {
+		req := msg.(*message.CreatePDPContextRequest)
+		imsi, nsapi, err := gtpv0.ParseTID(req.TID())
+		if err != nil {
+			return err
+		}
+
+		pdp := gtpv0.NewPDPContext(senderAddr, imsi, nsapi)
+		pdp.RemoteFlowLabelData = req.FlowLabelDataI.MustFlowLabelDataI()
+		pdp.RemoteFlowLabelSignalling = req.FlowLabelSignalling.MustFlowLabelSignalling()
+		pdp.LocalFlowLabelData = 0x3333
+		pdp.LocalFlowLabelSignalling = 0x4444
+		c.RegisterPDPContext(pdp)
+
+		res := message.NewCreatePDPContextResponse(
+			0, pdp.RemoteFlowLabelSignalling, req.Header.TID,
+			ie.NewCause(gtpv0.CauseRequestAccepted),
+			ie.NewFlowLabelDataI(pdp.LocalFlowLabelData),
+			ie.NewFlowLabelSignalling(pdp.LocalFlowLabelSignalling),
+		)
+		return c.RespondTo(senderAddr, msg, res)
+	})
+	go func() {
+		if err := ggsnConn.ListenAndServe(ctx); err != nil {
+			t.Log(err)
+		}
+	}()
+
+	// XXX - waiting for server to be well-prepared, should consider better way.
+	time.Sleep(100 * time.Millisecond)
+
+	sgsnConn, err := gtpv0.Dial(ctx, sgsnAddr, ggsnAddr, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createdCh := make(chan *gtpv0.PDPContext)
+	sgsnConn.AddHandler(message.MsgTypeCreatePDPContextResponse, func(c *gtpv0.Conn, senderAddr net.Addr, msg message.Message) error {
+		res := msg.(*message.CreatePDPContextResponse)
+		pdp, err := c.GetPDPContextByTID(res.TID())
+		if err != nil {
+			return err
+		}
+		pdp.RemoteFlowLabelData = res.FlowLabelDataI.MustFlowLabelDataI()
+		pdp.RemoteFlowLabelSignalling = res.FlowLabelSignalling.MustFlowLabelSignalling()
+		createdCh <- pdp
+		return nil
+	})
+
+	pdp, _, err := sgsnConn.CreatePDPContext(
+		ggsnAddr, "123456789012345", 5,
+		ie.NewQualityOfServiceProfile(1, 1, 1, 1, 1),
+		ie.NewSelectionMode(gtpv0.SelectionModeMSorNetworkProvidedAPNSubscribedVerified),
+		ie.NewFlowLabelDataI(0x1111),
+		ie.NewFlowLabelSignalling(0x2222),
+		ie.NewEndUserAddress("1.1.1.1"),
+		ie.NewAccessPointName("some.apn.example"),
+		ie.NewGSNAddress("127.0.0.1"),
+		ie.NewGSNAddress("127.0.0.1"),
+		ie.NewMSISDN("819012345678"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case created := <-createdCh:
+		if created != pdp {
+			t.Fatalf("got wrong PDPContext: %+v", created)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for Create PDP Context Response")
+	}
+
+	ggsnPDP, err := ggsnConn.GetPDPContextByIMSIAndNSAPI("123456789012345", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ggsnPDP.RemoteFlowLabelData != pdp.LocalFlowLabelData || pdp.RemoteFlowLabelData != ggsnPDP.LocalFlowLabelData {
+		t.Errorf("Flow Label mismatch. SGSN: %#x/%#x, GGSN: %#x/%#x",
+			pdp.LocalFlowLabelData, pdp.RemoteFlowLabelData, ggsnPDP.LocalFlowLabelData, ggsnPDP.RemoteFlowLabelData,
+		)
+	}
+
+	// exchange T-PDU in both directions.
+	tid, err := pdp.TID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := []byte{0xde, 0xad, 0xbe, 0xef}
+	for _, tc := range []struct {
+		description string
+		sender      *gtpv0.Conn
+		receiver    *gtpv0.Conn
+		label       uint16
+		raddr       net.Addr
+	}{
+		{"uplink", sgsnConn, ggsnConn, pdp.RemoteFlowLabelData, ggsnAddr},
+		{"downlink", ggsnConn, sgsnConn, ggsnPDP.RemoteFlowLabelData, sgsnAddr},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			if _, err := tc.sender.WriteToGTP(tid, tc.label, payload, tc.raddr); err != nil {
+				t.Fatal(err)
+			}
+
+			buf := make([]byte, 1500)
+			n, _, gotTID, gotLabel, err := tc.receiver.ReadFromGTP(buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(buf[:n], payload) {
+				t.Errorf("wrong payload. want %x, got: %x", payload, buf[:n])
+			}
+			if gotTID != tid || gotLabel != tc.label {
+				t.Errorf("wrong TID/Flow Label. want %#x/%#x, got: %#x/%#x", tid, tc.label, gotTID, gotLabel)
+			}
+		})
+	}
+}
@@ -4,6 +4,11 @@
 
 package gtpv0
 
+// Registered UDP port, which is used for both signalling and T-PDU.
+const (
+	GTPPort = ":3386"
+)
+
 // Cause definitions.
 const (
 	CauseRequestIMSI              uint8 = 0
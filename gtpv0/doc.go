@@ -4,7 +4,7 @@
 
 // Package gtpv0 provides simple and painless handling of GTPv0 protocol in pure Golang.
 //
-// This package is still under construction. The networking feature would be available in the future.
+// This package is still under construction. The networking feature is available with Conn.
 // Please see README.md for detailed usage of the APIs provided by this package.
 //
 // https://github.com/wmnsk/go-gtp/blob/master/gtpv0/README.md
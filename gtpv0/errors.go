@@ -0,0 +1,43 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv0
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnexpectedType indicates that the type of incoming message is not expected.
+	ErrUnexpectedType = errors.New("got unexpected type of message")
+
+	// ErrConnNotOpened indicates that some operation is failed due to the status of
+	// Conn is not valid.
+	ErrConnNotOpened = errors.New("connection is not opened")
+)
+
+// HandlerNotFoundError indicates that the handler func is not registered in *Conn
+// for the incoming GTPv0 message. In usual cases this error should not be taken
+// as fatal, as the other endpoint can make your program stop working just by
+// sending unregistered message.
+type HandlerNotFoundError struct {
+	MsgType string
+}
+
+// Error returns violating message type to handle.
+func (e *HandlerNotFoundError) Error() string {
+	return fmt.Sprintf("no handlers found for incoming message: %s, ignoring", e.MsgType)
+}
+
+// PDPContextNotFoundError indicates that no PDPContext found by lookup methods.
+type PDPContextNotFoundError struct {
+	IMSI  string
+	NSAPI uint8
+}
+
+// Error returns message with IMSI and NSAPI(=TID) used to look up PDPContext.
+func (e *PDPContextNotFoundError) Error() string {
+	return fmt.Sprintf("no PDPContext found: IMSI: %s, NSAPI: %d", e.IMSI, e.NSAPI)
+}
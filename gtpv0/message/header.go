@@ -136,6 +136,16 @@ func (h *Header) tid() string {
 	return utils.SwappedBytesToStr(b, false)
 }
 
+// Sequence returns SequenceNumber in uint16.
+func (h *Header) Sequence() uint16 {
+	return h.SequenceNumber
+}
+
+// SetSequenceNumber sets the SequenceNumber in Header.
+func (h *Header) SetSequenceNumber(seq uint16) {
+	h.SequenceNumber = seq
+}
+
 // Version returns the GTP version.
 func (h *Header) Version() int {
 	return 0
@@ -85,6 +85,8 @@ type Message interface {
 	MessageType() uint8
 	MessageTypeName() string
 	TID() string
+	Sequence() uint16
+	SetSequenceNumber(uint16)
 
 	// deprecated
 	SerializeTo([]byte) error
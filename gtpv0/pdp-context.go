@@ -0,0 +1,91 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv0
+
+import (
+	"net"
+	"sync"
+)
+
+// PDPContext is a PDP Context handled by Conn.
+//
+// In GTPv0, a PDPContext is identified by TID, which consists of IMSI and NSAPI.
+type PDPContext struct {
+	mu       sync.Mutex
+	peerAddr net.Addr
+
+	IMSI  string
+	NSAPI uint8
+
+	// LocalFlowLabelSignalling and LocalFlowLabelData are the Flow Labels allocated
+	// by this node, which the peer sets in the GTPv0 header.
+	LocalFlowLabelSignalling, LocalFlowLabelData uint16
+	// RemoteFlowLabelSignalling and RemoteFlowLabelData are the Flow Labels allocated
+	// by the peer, which are set in the GTPv0 header of the messages sent to the peer.
+	RemoteFlowLabelSignalling, RemoteFlowLabelData uint16
+}
+
+// NewPDPContext creates a new PDPContext with the peer's address, IMSI and NSAPI.
+func NewPDPContext(peerAddr net.Addr, imsi string, nsapi uint8) *PDPContext {
+	return &PDPContext{
+		mu:       sync.Mutex{},
+		peerAddr: peerAddr,
+		IMSI:     imsi,
+		NSAPI:    nsapi,
+	}
+}
+
+// TID returns the TID of PDPContext built from IMSI and NSAPI.
+func (p *PDPContext) TID() (uint64, error) {
+	return NewTID(p.IMSI, p.NSAPI)
+}
+
+// PeerAddr returns the address of the peer GSN.
+func (p *PDPContext) PeerAddr() net.Addr {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.peerAddr
+}
+
+// SetPeerAddr sets the address of the peer GSN.
+func (p *PDPContext) SetPeerAddr(peerAddr net.Addr) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.peerAddr = peerAddr
+}
+
+// pdpContextKey is the key to identify a PDPContext, which is equivalent to TID.
+type pdpContextKey struct {
+	imsi  string
+	nsapi uint8
+}
+
+type tidPDPContextMap struct {
+	syncMap sync.Map
+}
+
+func newtidPDPContextMap() *tidPDPContextMap {
+	return &tidPDPContextMap{}
+}
+
+func (m *tidPDPContextMap) store(imsi string, nsapi uint8, pdp *PDPContext) {
+	m.syncMap.Store(pdpContextKey{imsi, nsapi}, pdp)
+}
+
+func (m *tidPDPContextMap) load(imsi string, nsapi uint8) (*PDPContext, bool) {
+	pdp, ok := m.syncMap.Load(pdpContextKey{imsi, nsapi})
+	if ok && pdp != nil {
+		return pdp.(*PDPContext), true
+	}
+	return nil, false
+}
+
+func (m *tidPDPContextMap) delete(imsi string, nsapi uint8) {
+	m.syncMap.Delete(pdpContextKey{imsi, nsapi})
+}
+
+func (m *tidPDPContextMap) rangeWithFunc(fn func(key, pdp interface{}) bool) {
+	m.syncMap.Range(fn)
+}
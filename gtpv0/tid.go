@@ -0,0 +1,50 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv0
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewTID creates a TID in uint64 from IMSI and NSAPI.
+//
+// TID consists of IMSI(up to 15 digits) in TBCD encoding and NSAPI in the last
+// 4 bits. The unused digits of IMSI are filled with "f".
+func NewTID(imsi string, nsapi uint8) (uint64, error) {
+	if len(imsi) > 15 {
+		return 0, fmt.Errorf("too long IMSI for TID: %s", imsi)
+	}
+	if nsapi > 0xf {
+		return 0, fmt.Errorf("invalid NSAPI for TID: %d", nsapi)
+	}
+
+	b, err := utils.StrToSwappedBytes(imsi+strings.Repeat("f", 15-len(imsi)), "f")
+	if err != nil {
+		return 0, err
+	}
+	b[7] = (nsapi << 4) | (b[7] & 0x0f)
+
+	return binary.BigEndian.Uint64(b), nil
+}
+
+// ParseTID returns IMSI and NSAPI from TID in the human-readable string, which
+// is the one returned by TID method of message.Message.
+func ParseTID(tid string) (imsi string, nsapi uint8, err error) {
+	if len(tid) != 16 {
+		return "", 0, fmt.Errorf("invalid TID: %s", tid)
+	}
+
+	n, err := strconv.ParseUint(tid[15:], 16, 8)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid TID: %s", tid)
+	}
+
+	return strings.TrimRight(tid[:15], "f"), uint8(n), nil
+}
@@ -0,0 +1,614 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+)
+
+// CPlaneConn represents a C-Plane Connection of GTPv1, which is used on Gn/Gp
+// interface between SGSN and GGSN.
+type CPlaneConn struct {
+	mu      sync.Mutex
+	laddr   net.Addr
+	pktConn net.PacketConn
+	*msgHandlerMap
+	*teidPDPContextMap
+	*imsiNSAPIPDPContextMap
+
+	closeCh chan struct{}
+
+	// sequence is the last SequenceNumber used in the request.
+	//
+	// TS29.060 7.6 Reliable delivery of signalling messages;
+	// The Sequence Number shall be unique for each outstanding request message
+	// sourced from the same IP/UDP endpoint(=CPlaneConn).
+	sequence uint16
+
+	// restartCounter is the Restart Counter value in Recovery IE sent by CPlaneConn.
+	restartCounter uint8
+}
+
+// NewCPlaneConn creates a new CPlaneConn used for server. On client side, use DialCPlane instead.
+func NewCPlaneConn(laddr net.Addr, counter uint8) *CPlaneConn {
+	return &CPlaneConn{
+		mu:                     sync.Mutex{},
+		laddr:                  laddr,
+		msgHandlerMap:          newDefaultCPlaneMsgHandlerMap(),
+		teidPDPContextMap:      newteidPDPContextMap(),
+		imsiNSAPIPDPContextMap: newimsiNSAPIPDPContextMap(),
+		closeCh:                make(chan struct{}),
+		restartCounter:         counter,
+	}
+}
+
+// DialCPlane sends Echo Request to raddr to check if the endpoint is alive and returns CPlaneConn.
+//
+// It does not bind the raddr to the underlying connection, which enables a CPlaneConn to
+// send to/receive from multiple peers with single laddr.
+//
+// If Echo exchange is unnecessary, use NewCPlaneConn and ListenAndServe instead.
+func DialCPlane(ctx context.Context, laddr, raddr net.Addr, counter uint8) (*CPlaneConn, error) {
+	c := NewCPlaneConn(laddr, counter)
+
+	// setup underlying connection first.
+	var err error
+	c.pktConn, err = net.ListenPacket(c.laddr.Network(), c.laddr.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// release the underlying connection if Dial fails after this point.
+	fail := func(err error) (*CPlaneConn, error) {
+		_ = c.pktConn.Close()
+		return nil, err
+	}
+
+	// send EchoRequest to raddr.
+	if _, err := c.EchoRequest(raddr); err != nil {
+		return fail(err)
+	}
+
+	buf := make([]byte, 1500)
+
+	// if no response coming within 3 seconds, returns error without retrying.
+	if err := c.pktConn.SetReadDeadline(time.Now().Add(3 * time.Second)); err != nil {
+		return fail(err)
+	}
+	n, raddr, err := c.pktConn.ReadFrom(buf)
+	if err != nil {
+		return fail(err)
+	}
+	if err := c.pktConn.SetReadDeadline(time.Time{}); err != nil {
+		return fail(err)
+	}
+
+	// decode incoming message and let it be handled by default handler funcs.
+	msg, err := message.Parse(buf[:n])
+	if err != nil {
+		return fail(err)
+	}
+	if err := c.handleMessage(raddr, msg); err != nil {
+		return fail(err)
+	}
+
+	go func() {
+		if err := c.serve(ctx); err != nil {
+			logf("fatal error on CPlaneConn %s: %s", c.LocalAddr(), err)
+		}
+	}()
+	return c, nil
+}
+
+// ListenAndServe creates a new GTPv1-C *CPlaneConn and start serving.
+// This blocks, and returns error only if it face the fatal one. Non-fatal errors are logged
+// with logger. See SetLogger/EnableLogger/DisableLogger for handling of those logs.
+func (c *CPlaneConn) ListenAndServe(ctx context.Context) error {
+	if c.pktConn == nil {
+		var err error
+		c.mu.Lock()
+		c.pktConn, err = net.ListenPacket(c.laddr.Network(), c.laddr.String())
+		c.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.serve(ctx)
+}
+
+func (c *CPlaneConn) serve(ctx context.Context) error {
+	go func() {
+		select { // ctx is canceled or Close() is called
+		case <-ctx.Done():
+		case <-c.closed():
+		}
+
+		if err := c.pktConn.Close(); err != nil {
+			logf("error closing the underlying conn: %s", err)
+		}
+	}()
+
+	buf := make([]byte, 1500)
+	for {
+		n, raddr, err := c.pktConn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			// TODO: Use net.ErrClosed instead (available from Go 1.16).
+			// https://github.com/golang/go/commit/e9ad52e46dee4b4f9c73ff44f44e1e234815800f
+			if strings.Contains(err.Error(), "use of closed network connection") {
+				return nil
+			}
+			return fmt.Errorf("error reading from CPlaneConn %s: %w", c.LocalAddr(), err)
+		}
+
+		raw := make([]byte, n)
+		copy(raw, buf)
+		go func() {
+			msg, err := message.Parse(raw)
+			if err != nil {
+				logf("error parsing the message: %v, %x", err, raw)
+				return
+			}
+
+			if err := c.handleMessage(raddr, msg); err != nil {
+				logf("error handling message on CPlaneConn %s: %v", c.LocalAddr(), err)
+			}
+		}()
+	}
+}
+
+// ReadFrom reads a packet from the connection,
+// copying the payload into p. It returns the number of
+// bytes copied into p and the return address that
+// was on the packet.
+// It returns the number of bytes read (0 <= n <= len(p))
+// and any error encountered. Callers should always process
+// the n > 0 bytes returned before considering the error err.
+// ReadFrom can be made to time out and return
+// an Error with Timeout() == true after a fixed time limit;
+// see SetDeadline and SetReadDeadline.
+func (c *CPlaneConn) ReadFrom(p []byte) (n int, addr net.Addr, err error) {
+	return c.pktConn.ReadFrom(p)
+}
+
+// WriteTo writes a packet with payload p to addr.
+// WriteTo can be made to time out and return
+// an Error with Timeout() == true after a fixed time limit;
+// see SetDeadline and SetWriteDeadline.
+// On packet-oriented connections, write timeouts are rare.
+func (c *CPlaneConn) WriteTo(p []byte, addr net.Addr) (n int, err error) {
+	return c.pktConn.WriteTo(p, addr)
+}
+
+// closed would be used in multiple goroutines.
+// never send struct{}{} to it; instead, use close(c.closeCh).
+func (c *CPlaneConn) closed() <-chan struct{} {
+	return c.closeCh
+}
+
+// Close closes the connection.
+// Any blocked Read or Write operations will be unblocked and return errors.
+func (c *CPlaneConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	close(c.closeCh)
+
+	return nil
+}
+
+// LocalAddr returns the local network address.
+func (c *CPlaneConn) LocalAddr() net.Addr {
+	return c.pktConn.LocalAddr()
+}
+
+// SetDeadline sets the read and write deadlines associated
+// with the connection. It is equivalent to calling both
+// SetReadDeadline and SetWriteDeadline.
+//
+// A deadline is an absolute time after which I/O operations
+// fail with a timeout (see type Error) instead of
+// blocking. The deadline applies to all future and pending
+// I/O, not just the immediately following call to Read or
+// Write. After a deadline has been exceeded, the connection
+// can be refreshed by setting a deadline in the future.
+//
+// An idle timeout can be implemented by repeatedly extending
+// the deadline after successful Read or Write calls.
+//
+// A zero value for t means I/O operations will not time out.
+func (c *CPlaneConn) SetDeadline(t time.Time) error {
+	return c.pktConn.SetDeadline(t)
+}
+
+// SetReadDeadline sets the deadline for future Read calls
+// and any currently-blocked Read call.
+// A zero value for t means Read will not time out.
+func (c *CPlaneConn) SetReadDeadline(t time.Time) error {
+	return c.pktConn.SetReadDeadline(t)
+}
+
+// SetWriteDeadline sets the deadline for future Write calls
+// and any currently-blocked Write call.
+// Even if write times out, it may return n > 0, indicating that
+// some of the data was successfully written.
+// A zero value for t means Write will not time out.
+func (c *CPlaneConn) SetWriteDeadline(t time.Time) error {
+	return c.pktConn.SetWriteDeadline(t)
+}
+
+// AddHandler adds a message handler to *CPlaneConn.
+//
+// By adding HandlerFuncs, *CPlaneConn will handle the specified type of message
+// with it's paired HandlerFunc when receiving.
+// Messages without registered handlers are just ignored and discarded and the user will
+// get HandlerNotFoundError error.
+//
+// This should be performed just after creating *CPlaneConn, otherwise the user cannot retrieve
+// any values, which is in most cases vital to continue working as a node, from the incoming
+// message.
+//
+// HandlerFuncs for EchoRequest and EchoResponse are registered by default.
+// These HandlerFuncs can be overwritten by specifying message.MsgTypeEchoRequest and/or
+// message.MsgTypeEchoResponse as msgType parameter.
+func (c *CPlaneConn) AddHandler(msgType uint8, fn HandlerFunc) {
+	c.msgHandlerMap.store(msgType, fn)
+}
+
+// AddHandlers adds multiple handler funcs at a time.
+//
+// See AddHandler for detailed usage.
+func (c *CPlaneConn) AddHandlers(funcs map[uint8]HandlerFunc) {
+	for msgType, fn := range funcs {
+		c.msgHandlerMap.store(msgType, fn)
+	}
+}
+
+func (c *CPlaneConn) handleMessage(senderAddr net.Addr, msg message.Message) error {
+	handle, ok := c.msgHandlerMap.load(msg.MessageType())
+	if !ok {
+		return &HandlerNotFoundError{MsgType: msg.MessageTypeName()}
+	}
+
+	if err := handle(c, senderAddr, msg); err != nil {
+		return fmt.Errorf("failed to handle %s: %w", msg.MessageTypeName(), err)
+	}
+
+	return nil
+}
+
+// SendMessageTo sends a message to addr.
+// Unlike WriteTo, it sets the Sequence Number properly and returns the one
+// used in the message.
+func (c *CPlaneConn) SendMessageTo(msg message.Message, addr net.Addr) (uint16, error) {
+	seq := c.IncSequence()
+	msg.SetSequenceNumber(seq)
+
+	payload, err := message.Marshal(msg)
+	if err != nil {
+		msg.SetSequenceNumber(c.DecSequence())
+		return 0, fmt.Errorf("failed to send %T: %w", msg, err)
+	}
+
+	if _, err := c.WriteTo(payload, addr); err != nil {
+		msg.SetSequenceNumber(c.DecSequence())
+		return 0, fmt.Errorf("failed to send %T: %w", msg, err)
+	}
+	return seq, nil
+}
+
+// IncSequence increments the SequenceNumber associated with CPlaneConn.
+func (c *CPlaneConn) IncSequence() uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// SequenceNumber is 2-octet long and wraps around to 0.
+	c.sequence++
+	return c.sequence
+}
+
+// DecSequence decrements the SequenceNumber associated with CPlaneConn.
+func (c *CPlaneConn) DecSequence() uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sequence--
+
+	return c.sequence
+}
+
+// SequenceNumber returns the current(=last used) SequenceNumber associated with CPlaneConn.
+func (c *CPlaneConn) SequenceNumber() uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.sequence
+}
+
+// EchoRequest sends a EchoRequest.
+func (c *CPlaneConn) EchoRequest(raddr net.Addr) (uint16, error) {
+	return c.SendMessageTo(message.NewEchoRequest(0), raddr)
+}
+
+// EchoResponse sends a EchoResponse in response to the EchoRequest.
+func (c *CPlaneConn) EchoResponse(raddr net.Addr, req message.Message) error {
+	return c.RespondTo(raddr, req, message.NewEchoResponse(0, ie.NewRecovery(c.Restarts())))
+}
+
+// RespondTo sends a message(specified with "toBeSent" param) in response to
+// a message(specified with "received" param).
+//
+// This is to make it easier to handle SequenceNumber.
+func (c *CPlaneConn) RespondTo(raddr net.Addr, received, toBeSent message.Message) error {
+	toBeSent.SetSequenceNumber(received.Sequence())
+	b := make([]byte, toBeSent.MarshalLen())
+	if err := toBeSent.MarshalTo(b); err != nil {
+		return err
+	}
+
+	if _, err := c.WriteTo(b, raddr); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Restarts returns the number of restarts in uint8, which is given at the creation
+// of CPlaneConn.
+func (c *CPlaneConn) Restarts() uint8 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.restartCounter
+}
+
+// CreatePDPContext sends a CreatePDPContextRequest and stores information given with IE
+// in the PDPContext returned.
+//
+// The PDPContext is registered to CPlaneConn with IMSI, NSAPI and TEID C-Plane IEs
+// given, so that it can be looked up with GetPDPContextByTEID and
+// GetPDPContextByIMSIAndNSAPI later. TEID C-Plane IE is expected to be created
+// with NewTEIDCPlane. The values from the peer can be stored into the PDPContext
+// with UpdateFromResponse when the CreatePDPContextResponse comes.
+//
+// Note that this method doesn't care IEs given are sufficient or not, as the required IE
+// varies much depending on the context in which the Create PDP Context Request is used.
+func (c *CPlaneConn) CreatePDPContext(raddr net.Addr, ies ...*ie.IE) (*PDPContext, uint16, error) {
+	return c.createPDPContext(raddr, nil, ies...)
+}
+
+// CreateSecondaryPDPContext sends a CreatePDPContextRequest for the secondary PDP
+// Context that shares the PDP Address and APN with the primary one.
+//
+// The request is sent to the peer of the primary PDPContext, with the TEID in the
+// header set to its RemoteTEIDC. The IEs given should include NSAPI and Linked
+// NSAPI in this order. IMSI of the PDPContext returned is inherited from primary,
+// as it is not included in the request.
+func (c *CPlaneConn) CreateSecondaryPDPContext(primary *PDPContext, ies ...*ie.IE) (*PDPContext, uint16, error) {
+	return c.createPDPContext(primary.PeerAddr(), primary, ies...)
+}
+
+func (c *CPlaneConn) createPDPContext(raddr net.Addr, primary *PDPContext, ies ...*ie.IE) (*PDPContext, uint16, error) {
+	var teid uint32
+	if primary != nil {
+		teid = primary.RemoteTEIDC
+	}
+	msg := message.NewCreatePDPContextRequest(teid, 0, ies...)
+
+	pdp := NewPDPContext(raddr, "", 0)
+	if err := pdp.fillFromRequest(msg, true); err != nil {
+		return nil, 0, err
+	}
+	if primary != nil {
+		pdp.IMSI = primary.IMSI
+		pdp.MSISDN = primary.MSISDN
+		pdp.LinkedNSAPI = primary.NSAPI
+		if pdp.APN == "" {
+			pdp.APN = primary.APN
+		}
+	}
+
+	if msg.TEIDCPlane != nil {
+		c.RegisterPDPContext(pdp.LocalTEIDC, pdp)
+	} else {
+		c.imsiNSAPIPDPContextMap.store(pdp.IMSI, pdp.NSAPI, pdp)
+	}
+
+	seq, err := c.SendMessageTo(msg, raddr)
+	if err != nil {
+		c.RemovePDPContext(pdp)
+		return nil, 0, err
+	}
+	return pdp, seq, nil
+}
+
+// ParseCreatePDPContextRequest creates a new PDPContext from the CreatePDPContextRequest
+// received from raddr. See NewPDPContextFromRequest for what is stored in PDPContext.
+//
+// If the request is for the secondary PDP Context, the primary one is looked up by
+// the TEID in the header, and IMSI and MSISDN are inherited from it.
+//
+// The PDPContext returned is not registered to CPlaneConn; call RegisterPDPContext
+// with the TEID-C allocated for it.
+func (c *CPlaneConn) ParseCreatePDPContextRequest(raddr net.Addr, req *message.CreatePDPContextRequest) (*PDPContext, error) {
+	pdp, err := NewPDPContextFromRequest(raddr, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if pdp.IsSecondary() && pdp.IMSI == "" {
+		primary, err := c.GetPDPContextByTEID(req.TEID(), raddr)
+		if err != nil {
+			return nil, err
+		}
+		pdp.IMSI = primary.IMSI
+		pdp.MSISDN = primary.MSISDN
+		if pdp.APN == "" {
+			pdp.APN = primary.APN
+		}
+	}
+
+	return pdp, nil
+}
+
+// UpdatePDPContext sends an UpdatePDPContextRequest with IEs given to the peer of
+// the PDPContext. The TEID in the header is the RemoteTEIDC of the PDPContext.
+func (c *CPlaneConn) UpdatePDPContext(pdp *PDPContext, ies ...*ie.IE) (uint16, error) {
+	msg := message.NewUpdatePDPContextRequest(pdp.RemoteTEIDC, 0, ies...)
+	return c.SendMessageTo(msg, pdp.PeerAddr())
+}
+
+// DeletePDPContext sends a DeletePDPContextRequest with IEs given to the peer of
+// the PDPContext. The TEID in the header is the RemoteTEIDC of the PDPContext.
+//
+// The PDPContext is not removed from CPlaneConn by this method, as it is still
+// needed to handle the response. Call RemovePDPContext when the response comes.
+func (c *CPlaneConn) DeletePDPContext(pdp *PDPContext, ies ...*ie.IE) (uint16, error) {
+	msg := message.NewDeletePDPContextRequest(pdp.RemoteTEIDC, 0, ies...)
+	return c.SendMessageTo(msg, pdp.PeerAddr())
+}
+
+// GetPDPContextByTEID returns PDPContext looked up by the local TEID-C and
+// the sender of the message.
+func (c *CPlaneConn) GetPDPContextByTEID(teid uint32, peer net.Addr) (*PDPContext, error) {
+	pdp, ok := c.teidPDPContextMap.load(teid)
+	if !ok {
+		return nil, &InvalidTEIDError{TEID: teid}
+	}
+
+	pdp.mu.Lock()
+	defer pdp.mu.Unlock()
+	if peer.String() != pdp.peerAddrString {
+		return nil, &InvalidTEIDError{TEID: teid}
+	}
+	return pdp, nil
+}
+
+// GetPDPContextByIMSIAndNSAPI returns PDPContext looked up by IMSI and NSAPI.
+func (c *CPlaneConn) GetPDPContextByIMSIAndNSAPI(imsi string, nsapi uint8) (*PDPContext, error) {
+	if pdp, ok := c.imsiNSAPIPDPContextMap.load(imsi, nsapi); ok {
+		return pdp, nil
+	}
+	return nil, &PDPContextNotFoundError{IMSI: imsi, NSAPI: nsapi}
+}
+
+// GetPrimaryPDPContext returns the primary PDPContext linked to the secondary one given.
+func (c *CPlaneConn) GetPrimaryPDPContext(secondary *PDPContext) (*PDPContext, error) {
+	if !secondary.IsSecondary() {
+		return nil, &PDPContextNotFoundError{IMSI: secondary.IMSI, NSAPI: secondary.LinkedNSAPI}
+	}
+	return c.GetPDPContextByIMSIAndNSAPI(secondary.IMSI, secondary.LinkedNSAPI)
+}
+
+// SecondaryPDPContexts returns all the secondary PDPContexts linked to the primary one given.
+func (c *CPlaneConn) SecondaryPDPContexts(primary *PDPContext) []*PDPContext {
+	var pdps []*PDPContext
+	c.imsiNSAPIPDPContextMap.rangeWithFunc(func(k, v interface{}) bool {
+		pdp := v.(*PDPContext)
+		if pdp.IMSI == primary.IMSI && pdp.LinkedNSAPI == primary.NSAPI {
+			pdps = append(pdps, pdp)
+		}
+		return true
+	})
+
+	return pdps
+}
+
+// RegisterPDPContext registers PDPContext to CPlaneConn with its local TEID-C to
+// distinguish which PDPContext the incoming messages are for.
+func (c *CPlaneConn) RegisterPDPContext(teid uint32, pdp *PDPContext) {
+	pdp.LocalTEIDC = teid
+
+	c.teidPDPContextMap.store(teid, pdp)
+	c.imsiNSAPIPDPContextMap.store(pdp.IMSI, pdp.NSAPI, pdp)
+}
+
+// RemovePDPContext removes a PDPContext registered in CPlaneConn.
+func (c *CPlaneConn) RemovePDPContext(pdp *PDPContext) {
+	if cur, ok := c.imsiNSAPIPDPContextMap.load(pdp.IMSI, pdp.NSAPI); ok && cur == pdp {
+		c.imsiNSAPIPDPContextMap.delete(pdp.IMSI, pdp.NSAPI)
+	}
+	if cur, ok := c.teidPDPContextMap.load(pdp.LocalTEIDC); ok && cur == pdp {
+		c.teidPDPContextMap.delete(pdp.LocalTEIDC)
+	}
+}
+
+// RemovePDPContextByIMSIAndNSAPI removes a PDPContext looked up by IMSI and NSAPI.
+//
+// Use RemovePDPContext instead if you already have the PDPContext in your hand.
+func (c *CPlaneConn) RemovePDPContextByIMSIAndNSAPI(imsi string, nsapi uint8) {
+	pdp, ok := c.imsiNSAPIPDPContextMap.load(imsi, nsapi)
+	if !ok {
+		logf("PDPContext not found by IMSI: %s, NSAPI: %d", imsi, nsapi)
+		return
+	}
+	c.RemovePDPContext(pdp)
+}
+
+// NewTEIDCPlane creates a new TEID C-Plane IE with random TEID value that is unique
+// within CPlaneConn. To ensure the uniqueness, don't create in the other way if you
+// once use this method.
+//
+// Note that in the case there's a lot of PDPContext on the CPlaneConn, it may take a
+// long time to find a new unique value.
+func (c *CPlaneConn) NewTEIDCPlane() *ie.IE {
+	var teid uint32
+	for try := uint32(0); try < 0xffff; try++ {
+		const logEvery = 0xff
+		if try&logEvery == logEvery {
+			logf("Generating NewTEIDCPlane crossed tries:%d", try)
+		}
+
+		t := generateRandomUint32()
+		if t == 0 {
+			continue
+		}
+
+		// Try to mark TEID as taken. Fails if something exists
+		if ok := c.teidPDPContextMap.tryStore(t, nil); !ok {
+			continue
+		}
+
+		teid = t
+		break
+	}
+
+	if teid == 0 {
+		return nil
+	}
+	return ie.NewTEIDCPlane(teid)
+}
+
+// PDPContexts returns all the PDPContexts registered in CPlaneConn.
+func (c *CPlaneConn) PDPContexts() []*PDPContext {
+	var pdps []*PDPContext
+	c.imsiNSAPIPDPContextMap.rangeWithFunc(func(k, v interface{}) bool {
+		pdps = append(pdps, v.(*PDPContext))
+		return true
+	})
+
+	return pdps
+}
+
+// PDPContextCount returns the number of PDPContexts registered in CPlaneConn.
+func (c *CPlaneConn) PDPContextCount() int {
+	var count int
+	c.imsiNSAPIPDPContextMap.rangeWithFunc(func(k, v interface{}) bool {
+		count++
+		return true
+	})
+
+	return count
+}
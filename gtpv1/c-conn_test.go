@@ -0,0 +1,200 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv1_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv1"
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+)
+
+func TestCPlaneConn(t *testing.T) {
+	sgsnAddr, err := net.ResolveUDPAddr("udp", "127.0.0.5:2123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ggsnAddr, err := net.ResolveUDPAddr("udp", "127.0.0.6:2123")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ggsnConn := gtpv1.NewCPlaneConn(ggsnAddr, 0)
+	ggsnConn.AddHandlers(map[uint8]gtpv1.HandlerFunc{
+		message.MsgTypeCreatePDPContextRequest: func(c gtpv1.Conn, senderAddr net.Addr, msg message.Message) error {
+			pdp, err := ggsnConn.ParseCreatePDPContextRequest(senderAddr, msg.(*message.CreatePDPContextRequest))
+			if err != nil {
+				return err
+			}
+
+			teidC := ggsnConn.NewTEIDCPlane()
+			ggsnConn.RegisterPDPContext(teidC.MustTEID(), pdp)
+
+			res := message.NewCreatePDPContextResponse(
+				pdp.RemoteTEIDC, 0,
+				ie.NewCause(gtpv1.ResCauseRequestAccepted),
+				teidC,
+			)
+			return c.RespondTo(senderAddr, msg, res)
+		},
+		message.MsgTypeDeletePDPContextRequest: func(c gtpv1.Conn, senderAddr net.Addr, msg message.Message) error {
+			pdp, err := ggsnConn.GetPDPContextByTEID(msg.TEID(), senderAddr)
+			if err != nil {
+				return err
+			}
+			ggsnConn.RemovePDPContext(pdp)
+
+			res := message.NewDeletePDPContextResponse(
+				pdp.RemoteTEIDC, 0,
+				ie.NewCause(gtpv1.ResCauseRequestAccepted),
+			)
+			return c.RespondTo(senderAddr, msg, res)
+		},
+	})
+	go func() {
+		if err := ggsnConn.ListenAndServe(ctx); err != nil {
+			t.Log(err)
+		}
+	}()
+
+	// XXX - waiting for server to be well-prepared, should consider better way.
+	time.Sleep(100 * time.Millisecond)
+
+	sgsnConn, err := gtpv1.DialCPlane(ctx, sgsnAddr, ggsnAddr, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createdCh := make(chan *gtpv1.PDPContext)
+	deletedCh := make(chan *gtpv1.PDPContext)
+	sgsnConn.AddHandlers(map[uint8]gtpv1.HandlerFunc{
+		message.MsgTypeCreatePDPContextResponse: func(c gtpv1.Conn, senderAddr net.Addr, msg message.Message) error {
+			res := msg.(*message.CreatePDPContextResponse)
+			pdp, err := sgsnConn.GetPDPContextByTEID(res.TEID(), senderAddr)
+			if err != nil {
+				return err
+			}
+			if err := pdp.UpdateFromResponse(res); err != nil {
+				return err
+			}
+			createdCh <- pdp
+			return nil
+		},
+		message.MsgTypeDeletePDPContextResponse: func(c gtpv1.Conn, senderAddr net.Addr, msg message.Message) error {
+			pdp, err := sgsnConn.GetPDPContextByTEID(msg.TEID(), senderAddr)
+			if err != nil {
+				return err
+			}
+			sgsnConn.RemovePDPContext(pdp)
+			deletedCh <- pdp
+			return nil
+		},
+	})
+
+	pdp, seq, err := sgsnConn.CreatePDPContext(
+		ggsnAddr,
+		ie.NewIMSI("123451234567890"),
+		ie.NewNSAPI(5),
+		sgsnConn.NewTEIDCPlane(),
+		ie.NewAccessPointName("some.apn.example"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seq != sgsnConn.SequenceNumber() {
+		t.Errorf("wrong Sequence Number. want %d, got: %d", sgsnConn.SequenceNumber(), seq)
+	}
+
+	select {
+	case created := <-createdCh:
+		if created != pdp {
+			t.Fatalf("got wrong PDPContext: %+v", created)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for Create PDP Context Response")
+	}
+
+	got, err := sgsnConn.GetPDPContextByIMSIAndNSAPI("123451234567890", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != pdp {
+		t.Errorf("got wrong PDPContext: %+v", got)
+	}
+
+	ggsnPDP, err := ggsnConn.GetPDPContextByIMSIAndNSAPI("123451234567890", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ggsnPDP.LocalTEIDC != pdp.RemoteTEIDC || ggsnPDP.RemoteTEIDC != pdp.LocalTEIDC {
+		t.Errorf("TEID-C mismatch. SGSN: %#x/%#x, GGSN: %#x/%#x",
+			pdp.LocalTEIDC, pdp.RemoteTEIDC, ggsnPDP.LocalTEIDC, ggsnPDP.RemoteTEIDC,
+		)
+	}
+
+	if _, err := sgsnConn.DeletePDPContext(pdp, ie.NewTeardownInd(true), ie.NewNSAPI(5)); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-deletedCh:
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for Delete PDP Context Response")
+	}
+
+	if n := sgsnConn.PDPContextCount(); n != 0 {
+		t.Errorf("PDPContext remains on SGSN: %d", n)
+	}
+	if n := ggsnConn.PDPContextCount(); n != 0 {
+		t.Errorf("PDPContext remains on GGSN: %d", n)
+	}
+	if _, err := ggsnConn.GetPDPContextByTEID(ggsnPDP.LocalTEIDC, sgsnAddr); err == nil {
+		t.Error("PDPContext can still be looked up by TEID on GGSN")
+	}
+}
+
+func TestDialCPlaneFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer, err := net.ListenPacket("udp", "127.0.0.15"+gtpv1.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer peer.Close()
+
+	// respond to Echo Request with a truncated header that cannot be parsed.
+	go func() {
+		buf := make([]byte, 1500)
+		_, raddr, err := peer.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		if _, err := peer.WriteTo([]byte{0x32, 0x02, 0x00, 0x04}, raddr); err != nil {
+			return
+		}
+	}()
+
+	cliAddr, err := net.ResolveUDPAddr("udp", "127.0.0.16"+gtpv1.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gtpv1.DialCPlane(ctx, cliAddr, peer.LocalAddr(), 0); err == nil {
+		t.Fatal("DialCPlane unexpectedly succeeded")
+	}
+
+	// the local address should be available again, as DialCPlane releases it on failure.
+	conn, err := net.ListenPacket("udp", cliAddr.String())
+	if err != nil {
+		t.Fatalf("local address is not released: %v", err)
+	}
+	conn.Close()
+}
@@ -93,6 +93,14 @@ const (
 	PDPTypeIETF
 )
 
+// PDP Type Number definitions.
+const (
+	PDPTypePPP    uint8 = 0x01
+	PDPTypeIPv4   uint8 = 0x21
+	PDPTypeIPv6   uint8 = 0x57
+	PDPTypeIPv4v6 uint8 = 0x8d
+)
+
 // Protocol ID definitions.
 // For more identifiers, see RFC 3232.
 const (
@@ -164,6 +172,21 @@ const (
 	LocTypeRAI
 )
 
+// QoS Profile Traffic Class definitions.
+const (
+	TrafficClassSubscribed uint8 = iota
+	TrafficClassConversational
+	TrafficClassStreaming
+	TrafficClassInteractive
+	TrafficClassBackground
+)
+
+// CellIdentification SourceType definitions.
+const (
+	SourceTypeCell uint8 = iota
+	SourceTypeRNC
+)
+
 // APN Restriction definitions.
 const (
 	APNRestrictionNoExistingContextsorRestriction uint8 = iota
@@ -44,3 +44,25 @@ type HandlerNotFoundError struct {
 func (e *HandlerNotFoundError) Error() string {
 	return fmt.Sprintf("no handlers found for incoming message: %s, ignoring", e.MsgType)
 }
+
+// InvalidTEIDError indicates that the TEID value is different from expected one or
+// not registered in CPlaneConn.
+type InvalidTEIDError struct {
+	TEID uint32
+}
+
+// Error returns violating TEID.
+func (e *InvalidTEIDError) Error() string {
+	return fmt.Sprintf("got invalid TEID: %#08x", e.TEID)
+}
+
+// PDPContextNotFoundError indicates that no PDPContext found by lookup methods.
+type PDPContextNotFoundError struct {
+	IMSI  string
+	NSAPI uint8
+}
+
+// Error returns message with IMSI and NSAPI used to look up PDPContext.
+func (e *PDPContextNotFoundError) Error() string {
+	return fmt.Sprintf("no PDPContext found: IMSI: %s, NSAPI: %d", e.IMSI, e.NSAPI)
+}
@@ -49,6 +49,16 @@ func newDefaultMsgHandlerMap() *msgHandlerMap {
 			message.MsgTypeEchoRequest:     handleEchoRequest,
 			message.MsgTypeEchoResponse:    handleEchoResponse,
 			message.MsgTypeErrorIndication: handleErrorIndication,
+			message.MsgTypeEndMarker:       handleEndMarker,
+		},
+	)
+}
+
+func newDefaultCPlaneMsgHandlerMap() *msgHandlerMap {
+	return newMsgHandlerMap(
+		map[uint8]HandlerFunc{
+			message.MsgTypeEchoRequest:  handleEchoRequest,
+			message.MsgTypeEchoResponse: handleEchoResponse,
 		},
 	)
 }
@@ -81,6 +91,8 @@ func handleTPDU(c Conn, senderAddr net.Addr, msg message.Message) error {
 		teid:    pdu.TEID(),
 		seq:     pdu.Sequence(),
 		payload: pdu.Payload,
+
+		extHeaders: pdu.ExtensionHeaders,
 	}
 
 	// wait for the T-PDU passed to u.tpduCh to be read by ReadFromGTP.
@@ -99,10 +111,24 @@ func handleTPDU(c Conn, senderAddr net.Addr, msg message.Message) error {
 func handleEchoRequest(c Conn, senderAddr net.Addr, msg message.Message) error {
 	// this should never happen, as the type should have been assured by
 	// msgHandlerMap before this function is called.
-	if _, ok := msg.(*message.EchoRequest); !ok {
+	echo, ok := msg.(*message.EchoRequest)
+	if !ok {
 		return ErrUnexpectedType
 	}
 
+	// check if the peer has restarted. Recovery IE is not defined in Echo Request,
+	// but some implementations including UPlaneConn put it in.
+	if u, ok := c.(*UPlaneConn); ok {
+		var recovery *ie.IE
+		for _, i := range echo.AdditionalIEs {
+			if i != nil && i.Type == ie.Recovery {
+				recovery = i
+				break
+			}
+		}
+		u.handleRecovery(senderAddr, recovery)
+	}
+
 	// respond with EchoResponse.
 	return c.RespondTo(
 		senderAddr, msg, message.NewEchoResponse(0, ie.NewRecovery(c.Restarts())),
@@ -112,11 +138,19 @@ func handleEchoRequest(c Conn, senderAddr net.Addr, msg message.Message) error {
 func handleEchoResponse(c Conn, senderAddr net.Addr, msg message.Message) error {
 	// this should never happen, as the type should have been assured by
 	// msgHandlerMap before this function is called.
-	if _, ok := msg.(*message.EchoResponse); !ok {
+	echo, ok := msg.(*message.EchoResponse)
+	if !ok {
 		return ErrUnexpectedType
 	}
 
-	// do nothing.
+	// check if the peer has restarted. The peer is watched from now on if
+	// this is the response to Echo Request sent by UPlaneConn.
+	if u, ok := c.(*UPlaneConn); ok {
+		if u.pathMap.echoAnswered(senderAddr) {
+			u.AddPeer(senderAddr)
+		}
+		u.handleRecovery(senderAddr, echo.Recovery)
+	}
 	return nil
 }
 
@@ -135,3 +169,28 @@ func handleErrorIndication(c Conn, senderAddr net.Addr, msg message.Message) err
 	})
 	return nil
 }
+
+func handleEndMarker(c Conn, senderAddr net.Addr, msg message.Message) error {
+	// this should never happen, as the type should have been assured by
+	// msgHandlerMap before this function is called.
+	if _, ok := msg.(*message.EndMarker); !ok {
+		return ErrUnexpectedType
+	}
+
+	u, ok := c.(*UPlaneConn)
+	if !ok {
+		return ErrInvalidConnection
+	}
+
+	u.mu.Lock()
+	fn := u.endMarkerHandler
+	u.mu.Unlock()
+
+	if fn == nil {
+		logf("Ignored End Marker for TEID %#08x from %s", msg.TEID(), senderAddr)
+		return nil
+	}
+
+	fn(u, senderAddr, msg.TEID())
+	return nil
+}
@@ -0,0 +1,25 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewBSSContainer creates a new BSSContainer IE.
+func NewBSSContainer(container []byte) *IE {
+	return New(BSSContainer, container)
+}
+
+// BSSContainer returns BSSContainer value if type matches.
+func (i *IE) BSSContainer() ([]byte, error) {
+	if i.Type != BSSContainer {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	return i.Payload, nil
+}
+
+// MustBSSContainer returns BSSContainer in []byte if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustBSSContainer() []byte {
+	v, _ := i.BSSContainer()
+	return v
+}
@@ -0,0 +1,143 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewCellIdentification creates a new CellIdentification IE.
+//
+// targetRAI and sourceRAI should be RouteingAreaIdentity IEs. sourceID is
+// treated as Source Cell Identity if sourceType is 0(Cell), and Source RNC-ID
+// if sourceType is 1(RNC).
+func NewCellIdentification(targetRAI *IE, targetCI uint16, sourceType uint8, sourceRAI *IE, sourceID uint16) *IE {
+	if targetRAI == nil || targetRAI.Type != RouteingAreaIdentity || len(targetRAI.Payload) != 6 {
+		return nil
+	}
+	if sourceRAI == nil || sourceRAI.Type != RouteingAreaIdentity || len(sourceRAI.Payload) != 6 {
+		return nil
+	}
+
+	ci := New(
+		CellIdentification,
+		make([]byte, 17),
+	)
+	copy(ci.Payload[0:6], targetRAI.Payload)
+	binary.BigEndian.PutUint16(ci.Payload[6:8], targetCI)
+	ci.Payload[8] = sourceType
+	copy(ci.Payload[9:15], sourceRAI.Payload)
+	binary.BigEndian.PutUint16(ci.Payload[15:17], sourceID)
+
+	return ci
+}
+
+// CellIdentification returns CellIdentification value if type matches.
+func (i *IE) CellIdentification() ([]byte, error) {
+	if i.Type != CellIdentification {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	return i.Payload, nil
+}
+
+// MustCellIdentification returns CellIdentification in []byte if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustCellIdentification() []byte {
+	v, _ := i.CellIdentification()
+	return v
+}
+
+// TargetRAI returns the RAI of Target Cell ID in RouteingAreaIdentity IE if type matches.
+func (i *IE) TargetRAI() (*IE, error) {
+	if i.Type != CellIdentification {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 6 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return New(RouteingAreaIdentity, i.Payload[0:6]), nil
+}
+
+// MustTargetRAI returns TargetRAI in RouteingAreaIdentity IE if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustTargetRAI() *IE {
+	v, _ := i.TargetRAI()
+	return v
+}
+
+// TargetCI returns the Cell Identity of Target Cell ID if type matches.
+func (i *IE) TargetCI() (uint16, error) {
+	if i.Type != CellIdentification {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 8 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint16(i.Payload[6:8]), nil
+}
+
+// MustTargetCI returns TargetCI in uint16 if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustTargetCI() uint16 {
+	v, _ := i.TargetCI()
+	return v
+}
+
+// SourceType returns SourceType value if type matches.
+func (i *IE) SourceType() (uint8, error) {
+	if i.Type != CellIdentification {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 9 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return i.Payload[8], nil
+}
+
+// MustSourceType returns SourceType in uint8 if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSourceType() uint8 {
+	v, _ := i.SourceType()
+	return v
+}
+
+// SourceRAI returns the RAI of Source Cell ID or Source RNC-ID in RouteingAreaIdentity IE
+// if type matches.
+func (i *IE) SourceRAI() (*IE, error) {
+	if i.Type != CellIdentification {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 15 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return New(RouteingAreaIdentity, i.Payload[9:15]), nil
+}
+
+// MustSourceRAI returns SourceRAI in RouteingAreaIdentity IE if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSourceRAI() *IE {
+	v, _ := i.SourceRAI()
+	return v
+}
+
+// SourceID returns the Cell Identity of Source Cell ID or RNC-ID of Source RNC-ID
+// if type matches. Use SourceType to know which one it is.
+func (i *IE) SourceID() (uint16, error) {
+	if i.Type != CellIdentification {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 17 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint16(i.Payload[15:17]), nil
+}
+
+// MustSourceID returns SourceID in uint16 if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSourceID() uint16 {
+	v, _ := i.SourceID()
+	return v
+}
@@ -0,0 +1,49 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"testing"
+)
+
+func TestCellIdentification(t *testing.T) {
+	t.Run("Cell Identification", func(t *testing.T) {
+		ie := NewCellIdentification(
+			NewRouteingAreaIdentity("123", "45", 111, 222), 333,
+			1, NewRouteingAreaIdentity("543", "21", 444, 55), 666,
+		)
+
+		target := ie.MustTargetRAI()
+		if mcc := target.MustMCC(); mcc != "123" {
+			t.Errorf("wrong target mcc, got %v", mcc)
+		}
+		if lac := target.MustLAC(); lac != 111 {
+			t.Errorf("wrong target lac, got %v", lac)
+		}
+
+		ci := ie.MustTargetCI()
+		if ci != 333 {
+			t.Errorf("wrong target ci, got %v", ci)
+		}
+
+		st := ie.MustSourceType()
+		if st != 1 {
+			t.Errorf("wrong source type, got %v", st)
+		}
+
+		source := ie.MustSourceRAI()
+		if mnc := source.MustMNC(); mnc != "21" {
+			t.Errorf("wrong source mnc, got %v", mnc)
+		}
+		if rac := source.MustRAC(); rac != 55 {
+			t.Errorf("wrong source rac, got %v", rac)
+		}
+
+		id := ie.MustSourceID()
+		if id != 666 {
+			t.Errorf("wrong source id, got %v", id)
+		}
+	})
+}
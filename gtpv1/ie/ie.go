@@ -40,6 +40,7 @@ const (
 	TraceReference               uint8 = 27
 	TraceType                    uint8 = 28
 	MSNotReachableReason         uint8 = 29
+	PacketTransferCommand        uint8 = 126
 	ChargingID                   uint8 = 127
 )
 
@@ -141,7 +142,12 @@ const (
 	MappedUEUsageType                     uint8 = 223
 	UPFunctionSelectionIndicationFlags    uint8 = 224
 	SpecialIETypeForIETypeExtension       uint8 = 238
+	SequenceNumbersOfReleasedPackets      uint8 = 249
+	SequenceNumbersOfCancelledPackets     uint8 = 250
 	ChargingGatewayAddress                uint8 = 251
+	DataRecordPacket                      uint8 = 252
+	RequestsResponded                     uint8 = 253
+	AddressOfRecommendedNode              uint8 = 254
 	PrivateExtension                      uint8 = 255
 )
 
@@ -177,7 +183,12 @@ func (i *IE) MarshalTo(b []byte) error {
 
 	var offset = 1
 	b[0] = i.Type
-	if !i.IsTV() {
+	switch {
+	case i.IsTV():
+	case i.hasOneOctetLength():
+		b[1] = uint8(i.Length)
+		offset++
+	default:
 		binary.BigEndian.PutUint16(b[1:3], i.Length)
 		offset += 2
 	}
@@ -224,6 +235,16 @@ func decodeTVFromBytes(i *IE, b []byte) error {
 
 func decodeTLVFromBytes(i *IE, b []byte) error {
 	l := len(b)
+	if i.hasOneOctetLength() {
+		i.Length = uint16(b[1])
+		if int(i.Length)+2 > l {
+			return ErrInvalidLength
+		}
+
+		i.Payload = b[2 : 2+int(i.Length)]
+		return nil
+	}
+
 	if l < 3 {
 		return ErrTooShortToParse
 	}
@@ -265,6 +286,7 @@ var tvLengthMap = map[int]int{
 	27:  2,  // Trace Preference
 	28:  2,  // Trace Type
 	29:  1,  // MS Not Reachable Reason
+	126: 1,  // Packet Transfer Command
 	127: 4,  // Charging ID
 }
 
@@ -281,10 +303,19 @@ func (i *IE) MarshalLen() int {
 	if i.Type < 128 {
 		return 1 + len(i.Payload)
 	}
+	if i.hasOneOctetLength() {
+		return 2 + len(i.Payload)
+	}
 
 	return 3 + len(i.Payload)
 }
 
+// hasOneOctetLength reports whether the IE is a TLV with the Length field
+// of one octet instead of two, which is the exception defined in TS 29.060.
+func (i *IE) hasOneOctetLength() bool {
+	return i.Type == ExtensionHeaderTypeList
+}
+
 // SetLength sets the length in Length field.
 func (i *IE) SetLength() {
 	if _, ok := tvLengthMap[int(i.Type)]; ok {
@@ -340,12 +371,11 @@ func newUint8ValIE(t, v uint8) *IE {
 	return New(t, []byte{v})
 }
 
-// left for future use.
-// func newUint16ValIE(t uint8, v uint16) *IE {
-// 	i := New(t, make([]byte, 2))
-// 	binary.BigEndian.PutUint16(i.Payload, v)
-// 	return i
-// }
+func newUint16ValIE(t uint8, v uint16) *IE {
+	i := New(t, make([]byte, 2))
+	binary.BigEndian.PutUint16(i.Payload, v)
+	return i
+}
 
 func newUint32ValIE(t uint8, v uint32) *IE {
 	i := New(t, make([]byte, 4))
@@ -450,6 +480,11 @@ var ieTypeNameMap = map[uint8]string{
 	223: "MappedUEUsageType",
 	224: "UPFunctionSelectionIndicationFlags",
 	238: "SpecialIETypeForIETypeExtension",
+	249: "SequenceNumbersOfReleasedPackets",
+	250: "SequenceNumbersOfCancelledPackets",
 	251: "ChargingGatewayAddress",
+	252: "DataRecordPacket",
+	253: "RequestsResponded",
+	254: "AddressOfRecommendedNode",
 	255: "PrivateExtension",
 }
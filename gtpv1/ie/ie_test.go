@@ -117,6 +117,52 @@ func TestIEs(t *testing.T) {
 			"MSISDN",
 			ie.NewMSISDN("818012345678"),
 			[]byte{0x86, 0x00, 0x07, 0x91, 0x18, 0x08, 0x21, 0x43, 0x65, 0x87},
+		}, {
+			"QoSProfile",
+			ie.NewQoSProfileByFields(&ie.QoSProfileFields{
+				ARP:                       1,
+				DelayClass:                4,
+				ReliabilityClass:          3,
+				PeakThroughput:            9,
+				PrecedenceClass:           2,
+				MeanThroughput:            31,
+				TrafficClass:              gtpv1.TrafficClassBackground,
+				DeliveryOrder:             2,
+				DeliveryOfErroneousSDU:    1,
+				MaximumSDUSize:            0x97,
+				MaximumBitRateForUplink:   8640,
+				MaximumBitRateForDownlink: 8640,
+				ResidualBER:               7,
+				SDUErrorRatio:             4,
+				TransferDelay:             62,
+				TrafficHandlingPriority:   1,
+			}),
+			[]byte{0x87, 0x00, 0x0c, 0x01, 0x23, 0x92, 0x1f, 0x91, 0x97, 0xfe, 0xfe, 0x74, 0xf9, 0xff, 0xff},
+		}, {
+			"QoSProfile/ExtendedBitRates",
+			ie.NewQoSProfileByFields(&ie.QoSProfileFields{
+				ARP:                       2,
+				TrafficClass:              gtpv1.TrafficClassInteractive,
+				DeliveryOrder:             2,
+				DeliveryOfErroneousSDU:    3,
+				MaximumSDUSize:            0x96,
+				MaximumBitRateForUplink:   100000,
+				MaximumBitRateForDownlink: 1000000,
+				ResidualBER:               7,
+				SDUErrorRatio:             4,
+				TrafficHandlingPriority:   1,
+			}),
+			[]byte{
+				0x87, 0x00, 0x13,
+				// ARP, QoS Profile Data
+				0x02, 0x00, 0x00, 0x00, 0x73, 0x96, 0xfe, 0xfe, 0x74, 0x01, 0xff, 0xff, 0x00,
+				// Extended bit rates for downlink
+				0xfa, 0x00,
+				// Extended bit rates for uplink
+				0x9e, 0x00,
+				// Extended-2 bit rates for downlink
+				0x6f, 0x00,
+			},
 		}, {
 			"AuthenticationQuintuplet",
 			ie.NewAuthenticationQuintuplet(
@@ -136,6 +182,30 @@ func TestIEs(t *testing.T) {
 				0x10,
 				0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
 			},
+		}, {
+			"TargetIdentification",
+			ie.NewTargetIdentification("123", "45", 0x1111, 0x22, 0x0fff),
+			[]byte{0x8a, 0x00, 0x08, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x0f, 0xff},
+		}, {
+			"TargetIdentificationWithExtendedRNCID",
+			ie.NewTargetIdentificationWithExtendedRNCID("123", "45", 0x1111, 0x22, 0x0fff, 0xffff),
+			[]byte{0x8a, 0x00, 0x0a, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x0f, 0xff, 0xff, 0xff},
+		}, {
+			"UTRANTransparentContainer",
+			ie.NewUTRANTransparentContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+			[]byte{0x8b, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef},
+		}, {
+			"RABSetupInformation",
+			ie.NewRABSetupInformation(5, 0xdeadbeef, "1.1.1.1"),
+			[]byte{0x8c, 0x00, 0x09, 0x05, 0xde, 0xad, 0xbe, 0xef, 0x01, 0x01, 0x01, 0x01},
+		}, {
+			"RABSetupInformation/Release",
+			ie.NewRABSetupInformation(5, 0, ""),
+			[]byte{0x8c, 0x00, 0x01, 0x05},
+		}, {
+			"RANTransparentContainer",
+			ie.NewRANTransparentContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+			[]byte{0x90, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef},
 		}, {
 			"CommonFlags",
 			ie.NewCommonFlags(0, 1, 0, 0, 0, 0, 0, 0),
@@ -172,6 +242,67 @@ func TestIEs(t *testing.T) {
 			"IMEISV",
 			ie.NewIMEISV("123450123456789"),
 			[]byte{0x9a, 0x00, 0x08, 0x21, 0x43, 0x05, 0x21, 0x43, 0x65, 0x87, 0xf9},
+		}, {
+			"MBMSUEContext",
+			ie.NewMBMSUEContext([]byte{0x05, 0xde, 0xad, 0xbe, 0xef}),
+			[]byte{0x9c, 0x00, 0x05, 0x05, 0xde, 0xad, 0xbe, 0xef},
+		}, {
+			"TemporaryMobileGroupIdentity",
+			ie.NewTemporaryMobileGroupIdentity(0x123456, "123", "45"),
+			[]byte{0x9d, 0x00, 0x06, 0x12, 0x34, 0x56, 0x21, 0xf3, 0x54},
+		}, {
+			"MBMSServiceArea",
+			ie.NewMBMSServiceArea(1, 2),
+			[]byte{0xa0, 0x00, 0x05, 0x01, 0x00, 0x01, 0x00, 0x02},
+		}, {
+			"MBMSSessionIdentifier",
+			ie.NewMBMSSessionIdentifier(1),
+			[]byte{0xa5, 0x00, 0x01, 0x01},
+		}, {
+			"MBMSSessionDuration",
+			ie.NewMBMSSessionDuration(24*time.Hour + 10*time.Second),
+			[]byte{0xa8, 0x00, 0x03, 0x00, 0x05, 0x01},
+		}, {
+			"MBMSSessionRepetitionNumber",
+			ie.NewMBMSSessionRepetitionNumber(3),
+			[]byte{0xaa, 0x00, 0x01, 0x03},
+		}, {
+			"BSSContainer",
+			ie.NewBSSContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+			[]byte{0xad, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef},
+		}, {
+			"CellIdentification",
+			ie.NewCellIdentification(
+				ie.NewRouteingAreaIdentity("123", "45", 0x1111, 0x22), 0x3333,
+				gtpv1.SourceTypeRNC, ie.NewRouteingAreaIdentity("123", "45", 0x1111, 0x22), 0x0fff,
+			),
+			[]byte{
+				0xae, 0x00, 0x11,
+				// Target Cell ID
+				0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x33, 0x33,
+				// Source Type
+				0x01,
+				// Source RNC-ID
+				0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x0f, 0xff,
+			},
+		}, {
+			"MBMSFlowIdentifier",
+			ie.NewMBMSFlowIdentifier(0x1234),
+			[]byte{0xb9, 0x00, 0x02, 0x12, 0x34},
+		}, {
+			"MBMSIPMulticastDistribution",
+			ie.NewMBMSIPMulticastDistribution(0xdeadbeef, "239.0.0.1", "1.1.1.1", 1),
+			[]byte{
+				0xba, 0x00, 0x0f,
+				// Common TEID
+				0xde, 0xad, 0xbe, 0xef,
+				// IP Multicast Distribution Address
+				0x04, 0xef, 0x00, 0x00, 0x01,
+				// IP Multicast Source Address
+				0x04, 0x01, 0x01, 0x01, 0x01,
+				// MBMS HC Indicator
+				0x01,
+			},
 		}, {
 			"ULITimestamp",
 			ie.NewULITimestamp(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
@@ -181,6 +312,11 @@ func TestIEs(t *testing.T) {
 			ie.NewChargingID(0xffffffff),
 			[]byte{0x7f, 0xff, 0xff, 0xff, 0xff},
 		},
+		{
+			"ExtensionHeaderTypeList",
+			ie.NewExtensionHeaderTypeList(0x40, 0x85),
+			[]byte{0x8d, 0x02, 0x40, 0x85},
+		},
 		{
 			"PrivateExtension",
 			ie.NewPrivateExtension(0x0080, []byte{0xde, 0xad, 0xbe, 0xef}),
@@ -23,6 +23,11 @@ func (i *IE) IP() (net.IP, error) {
 		return net.IP(i.Payload[2:]), nil
 	case GSNAddress:
 		return net.IP(i.Payload), nil
+	case RABSetupInformation:
+		if len(i.Payload) < 9 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return net.IP(i.Payload[5:]), nil
 	default:
 		return nil, &InvalidTypeError{i.Type}
 	}
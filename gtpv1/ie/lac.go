@@ -12,7 +12,7 @@ import (
 // LAC returns LAC value if type matches.
 func (i *IE) LAC() (uint16, error) {
 	switch i.Type {
-	case RouteingAreaIdentity:
+	case RouteingAreaIdentity, TargetIdentification:
 		if len(i.Payload) < 5 {
 			return 0, io.ErrUnexpectedEOF
 		}
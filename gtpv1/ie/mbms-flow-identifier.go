@@ -0,0 +1,34 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewMBMSFlowIdentifier creates a new MBMSFlowIdentifier IE.
+func NewMBMSFlowIdentifier(id uint16) *IE {
+	return newUint16ValIE(MBMSFlowIdentifier, id)
+}
+
+// MBMSFlowIdentifier returns MBMSFlowIdentifier value if type matches.
+func (i *IE) MBMSFlowIdentifier() (uint16, error) {
+	if i.Type != MBMSFlowIdentifier {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 2 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return binary.BigEndian.Uint16(i.Payload[0:2]), nil
+}
+
+// MustMBMSFlowIdentifier returns MBMSFlowIdentifier in uint16 if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSFlowIdentifier() uint16 {
+	v, _ := i.MBMSFlowIdentifier()
+	return v
+}
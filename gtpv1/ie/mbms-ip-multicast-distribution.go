@@ -0,0 +1,157 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+)
+
+// NewMBMSIPMulticastDistribution creates a new MBMSIPMulticastDistribution IE.
+func NewMBMSIPMulticastDistribution(cteid uint32, distAddr, srcAddr string, hcIndicator uint8) *IE {
+	dist := net.ParseIP(distAddr)
+	if dist == nil {
+		return nil
+	}
+	src := net.ParseIP(srcAddr)
+	if src == nil {
+		return nil
+	}
+
+	distType, dist := mbmsAddressTypeAndIP(dist)
+	srcType, src := mbmsAddressTypeAndIP(src)
+
+	ipmd := New(
+		MBMSIPMulticastDistribution,
+		make([]byte, 4+1+len(dist)+1+len(src)+1),
+	)
+	binary.BigEndian.PutUint32(ipmd.Payload[0:4], cteid)
+	offset := 4
+
+	ipmd.Payload[offset] = distType<<6 | uint8(len(dist))
+	copy(ipmd.Payload[offset+1:], dist)
+	offset += 1 + len(dist)
+
+	ipmd.Payload[offset] = srcType<<6 | uint8(len(src))
+	copy(ipmd.Payload[offset+1:], src)
+	offset += 1 + len(src)
+
+	ipmd.Payload[offset] = hcIndicator
+	return ipmd
+}
+
+// mbmsAddressTypeAndIP returns the Address Type and the IP address in the length
+// that corresponds to the type.
+func mbmsAddressTypeAndIP(ip net.IP) (uint8, net.IP) {
+	if v4 := ip.To4(); v4 != nil {
+		return 0, v4
+	}
+	return 1, ip.To16()
+}
+
+// MBMSIPMulticastDistribution returns MBMSIPMulticastDistribution value if type matches.
+func (i *IE) MBMSIPMulticastDistribution() ([]byte, error) {
+	if i.Type != MBMSIPMulticastDistribution {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	return i.Payload, nil
+}
+
+// MustMBMSIPMulticastDistribution returns MBMSIPMulticastDistribution in []byte if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSIPMulticastDistribution() []byte {
+	v, _ := i.MBMSIPMulticastDistribution()
+	return v
+}
+
+// CommonTEID returns Common Tunnel Endpoint Identifier value if type matches.
+func (i *IE) CommonTEID() (uint32, error) {
+	if i.Type != MBMSIPMulticastDistribution {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint32(i.Payload[0:4]), nil
+}
+
+// MustCommonTEID returns Common Tunnel Endpoint Identifier in uint32 if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustCommonTEID() uint32 {
+	v, _ := i.CommonTEID()
+	return v
+}
+
+// IPMulticastDistributionAddress returns IP Multicast Distribution Address value
+// if type matches.
+func (i *IE) IPMulticastDistributionAddress() (net.IP, error) {
+	if i.Type != MBMSIPMulticastDistribution {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 5 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	n := int(i.Payload[4] & 0x3f)
+	if len(i.Payload) < 5+n {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return net.IP(i.Payload[5 : 5+n]), nil
+}
+
+// MustIPMulticastDistributionAddress returns IP Multicast Distribution Address in net.IP
+// if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustIPMulticastDistributionAddress() net.IP {
+	v, _ := i.IPMulticastDistributionAddress()
+	return v
+}
+
+// IPMulticastSourceAddress returns IP Multicast Source Address value if type matches.
+func (i *IE) IPMulticastSourceAddress() (net.IP, error) {
+	dist, err := i.IPMulticastDistributionAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	offset := 5 + len(dist)
+	if len(i.Payload) < offset+1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	n := int(i.Payload[offset] & 0x3f)
+	if len(i.Payload) < offset+1+n {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return net.IP(i.Payload[offset+1 : offset+1+n]), nil
+}
+
+// MustIPMulticastSourceAddress returns IP Multicast Source Address in net.IP if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustIPMulticastSourceAddress() net.IP {
+	v, _ := i.IPMulticastSourceAddress()
+	return v
+}
+
+// MBMSHCIndicator returns MBMS HC Indicator value if type matches.
+func (i *IE) MBMSHCIndicator() (uint8, error) {
+	src, err := i.IPMulticastSourceAddress()
+	if err != nil {
+		return 0, err
+	}
+
+	offset := 5 + int(i.Payload[4]&0x3f) + 1 + len(src)
+	if len(i.Payload) < offset+1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return i.Payload[offset], nil
+}
+
+// MustMBMSHCIndicator returns MBMS HC Indicator in uint8 if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSHCIndicator() uint8 {
+	v, _ := i.MBMSHCIndicator()
+	return v
+}
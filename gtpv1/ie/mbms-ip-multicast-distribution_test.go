@@ -0,0 +1,35 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"testing"
+)
+
+func TestMBMSIPMulticastDistribution(t *testing.T) {
+	t.Run("MBMS IP Multicast Distribution", func(t *testing.T) {
+		ie := NewMBMSIPMulticastDistribution(0xdeadbeef, "ff0e::1", "2001::1", 1)
+
+		cteid := ie.MustCommonTEID()
+		if cteid != 0xdeadbeef {
+			t.Errorf("wrong common teid, got %v", cteid)
+		}
+
+		dist := ie.MustIPMulticastDistributionAddress()
+		if dist.String() != "ff0e::1" {
+			t.Errorf("wrong distribution address, got %v", dist)
+		}
+
+		src := ie.MustIPMulticastSourceAddress()
+		if src.String() != "2001::1" {
+			t.Errorf("wrong source address, got %v", src)
+		}
+
+		hc := ie.MustMBMSHCIndicator()
+		if hc != 1 {
+			t.Errorf("wrong hc indicator, got %v", hc)
+		}
+	})
+}
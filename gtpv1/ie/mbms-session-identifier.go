@@ -0,0 +1,31 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewMBMSSessionIdentifier creates a new MBMSSessionIdentifier IE.
+func NewMBMSSessionIdentifier(id uint8) *IE {
+	return newUint8ValIE(MBMSSessionIdentifier, id)
+}
+
+// MBMSSessionIdentifier returns MBMSSessionIdentifier value if type matches.
+func (i *IE) MBMSSessionIdentifier() (uint8, error) {
+	if i.Type != MBMSSessionIdentifier {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0], nil
+}
+
+// MustMBMSSessionIdentifier returns MBMSSessionIdentifier in uint8 if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSSessionIdentifier() uint8 {
+	v, _ := i.MBMSSessionIdentifier()
+	return v
+}
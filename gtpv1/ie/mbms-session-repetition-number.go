@@ -0,0 +1,31 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewMBMSSessionRepetitionNumber creates a new MBMSSessionRepetitionNumber IE.
+func NewMBMSSessionRepetitionNumber(num uint8) *IE {
+	return newUint8ValIE(MBMSSessionRepetitionNumber, num)
+}
+
+// MBMSSessionRepetitionNumber returns MBMSSessionRepetitionNumber value if type matches.
+func (i *IE) MBMSSessionRepetitionNumber() (uint8, error) {
+	if i.Type != MBMSSessionRepetitionNumber {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0], nil
+}
+
+// MustMBMSSessionRepetitionNumber returns MBMSSessionRepetitionNumber in uint8 if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSSessionRepetitionNumber() uint8 {
+	v, _ := i.MBMSSessionRepetitionNumber()
+	return v
+}
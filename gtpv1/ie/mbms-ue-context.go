@@ -0,0 +1,29 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewMBMSUEContext creates a new MBMSUEContext IE.
+//
+// XXX - NOT Fully implemented. Users need to put the whole payload in []byte.
+func NewMBMSUEContext(payload []byte) *IE {
+	return New(MBMSUEContext, payload)
+}
+
+// MBMSUEContext returns MBMSUEContext if type matches.
+//
+// XXX - NOT Fully implemented. This method just returns the whole payload in []byte.
+func (i *IE) MBMSUEContext() ([]byte, error) {
+	if i.Type != MBMSUEContext {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	return i.Payload, nil
+}
+
+// MustMBMSUEContext returns MBMSUEContext in []byte if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSUEContext() []byte {
+	v, _ := i.MBMSUEContext()
+	return v
+}
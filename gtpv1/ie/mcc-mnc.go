@@ -13,7 +13,7 @@ import (
 // MCC returns MCC value if type matches.
 func (i *IE) MCC() (string, error) {
 	switch i.Type {
-	case RouteingAreaIdentity:
+	case RouteingAreaIdentity, TargetIdentification:
 		if len(i.Payload) < 2 {
 			return "", io.ErrUnexpectedEOF
 		}
@@ -23,6 +23,11 @@ func (i *IE) MCC() (string, error) {
 			return "", io.ErrUnexpectedEOF
 		}
 		return utils.DecodeMCC(i.Payload[1:3]), nil
+	case TemporaryMobileGroupIdentity:
+		if len(i.Payload) < 5 {
+			return "", io.ErrUnexpectedEOF
+		}
+		return utils.DecodeMCC(i.Payload[3:5]), nil
 	default:
 		return "", &InvalidTypeError{Type: i.Type}
 	}
@@ -38,7 +43,7 @@ func (i *IE) MustMCC() string {
 // MNC returns MNC value if type matches.
 func (i *IE) MNC() (string, error) {
 	switch i.Type {
-	case RouteingAreaIdentity:
+	case RouteingAreaIdentity, TargetIdentification:
 		if len(i.Payload) < 3 {
 			return "", io.ErrUnexpectedEOF
 		}
@@ -48,6 +53,11 @@ func (i *IE) MNC() (string, error) {
 			return "", io.ErrUnexpectedEOF
 		}
 		return utils.DecodeMNC(i.Payload[2:4]), nil
+	case TemporaryMobileGroupIdentity:
+		if len(i.Payload) < 6 {
+			return "", io.ErrUnexpectedEOF
+		}
+		return utils.DecodeMNC(i.Payload[4:6]), nil
 	default:
 		return "", &InvalidTypeError{Type: i.Type}
 	}
@@ -13,14 +13,18 @@ func NewNSAPI(nsapi uint8) *IE {
 
 // NSAPI returns NSAPI value if type matches.
 func (i *IE) NSAPI() (uint8, error) {
-	if i.Type != NSAPI {
-		return 0, &InvalidTypeError{Type: i.Type}
-	}
 	if len(i.Payload) == 0 {
 		return 0, io.ErrUnexpectedEOF
 	}
 
-	return i.Payload[0], nil
+	switch i.Type {
+	case NSAPI:
+		return i.Payload[0], nil
+	case RABSetupInformation:
+		return i.Payload[0] & 0x0f, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
 }
 
 // MustNSAPI returns NSAPI in uint8 if type matches.
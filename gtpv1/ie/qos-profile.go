@@ -4,16 +4,30 @@
 
 package ie
 
+import "io"
+
 // NewQoSProfile creates a new QoSProfile IE.
 //
-// XXX - NOT Fully implemented. Users need to put the whole payload in []byte.
+// The payload should contain Allocation/Retention Priority followed by the
+// QoS Profile Data. Use NewQoSProfileByFields to build it from each field.
 func NewQoSProfile(payload []byte) *IE {
 	return New(QoSProfile, payload)
 }
 
+// NewQoSProfileByFields creates a new QoSProfile IE from QoSProfileFields.
+func NewQoSProfileByFields(f *QoSProfileFields) *IE {
+	b, err := f.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(QoSProfile, b)
+}
+
 // QoSProfile returns QoSProfile if type matches.
 //
-// XXX - NOT Fully implemented. This method just returns the whole payload in []byte.
+// This method returns the whole payload in []byte. Use QoSProfileFields to
+// get the value of each field.
 func (i *IE) QoSProfile() ([]byte, error) {
 	if i.Type != QoSProfile {
 		return nil, &InvalidTypeError{Type: i.Type}
@@ -27,3 +41,287 @@ func (i *IE) MustQoSProfile() []byte {
 	v, _ := i.QoSProfile()
 	return v
 }
+
+// QoSProfileFields returns QoSProfile in QoSProfileFields type if type matches.
+func (i *IE) QoSProfileFields() (*QoSProfileFields, error) {
+	if i.Type != QoSProfile {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return ParseQoSProfileFields(i.Payload)
+}
+
+// MustQoSProfileFields returns QoSProfile in QoSProfileFields type if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustQoSProfileFields() *QoSProfileFields {
+	v, _ := i.QoSProfileFields()
+	return v
+}
+
+// QoSProfileFields is a set of fields in QoSProfile IE.
+//
+// The fields other than ARP are the Quality of Service defined in TS 24.008
+// 10.5.6.5, and the values are the ones encoded in each field as they are,
+// except for the bit rates, which are given in kbps.
+//
+// A bit rate is encoded in the shortest form that can carry it, using the
+// extended and extended-2 octets if it exceeds 8640 kbps and 256 Mbps
+// respectively. The value that cannot be represented exactly is rounded down
+// to the nearest one that can be, and 0 is encoded as 0 kbps(0xff). When
+// decoding, both 0xff and 0x00(subscribed/reserved) are treated as 0.
+type QoSProfileFields struct {
+	ARP                          uint8
+	DelayClass                   uint8 // 3-bit
+	ReliabilityClass             uint8 // 3-bit
+	PeakThroughput               uint8 // 4-bit
+	PrecedenceClass              uint8 // 3-bit
+	MeanThroughput               uint8 // 5-bit
+	TrafficClass                 uint8 // 3-bit
+	DeliveryOrder                uint8 // 2-bit
+	DeliveryOfErroneousSDU       uint8 // 3-bit
+	MaximumSDUSize               uint8
+	MaximumBitRateForUplink      uint64 // kbps
+	MaximumBitRateForDownlink    uint64 // kbps
+	ResidualBER                  uint8  // 4-bit
+	SDUErrorRatio                uint8  // 4-bit
+	TransferDelay                uint8  // 6-bit
+	TrafficHandlingPriority      uint8  // 2-bit
+	GuaranteedBitRateForUplink   uint64 // kbps
+	GuaranteedBitRateForDownlink uint64 // kbps
+	SignallingIndication         uint8  // 1-bit
+	SourceStatisticsDescriptor   uint8  // 4-bit
+}
+
+// Marshal serializes QoSProfileFields.
+func (f *QoSProfileFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes QoSProfileFields.
+func (f *QoSProfileFields) MarshalTo(b []byte) error {
+	l := f.MarshalLen()
+	if len(b) < l {
+		return io.ErrUnexpectedEOF
+	}
+
+	umbr, umbrExt, umbrExt2 := encodeBitRate(f.MaximumBitRateForUplink)
+	dmbr, dmbrExt, dmbrExt2 := encodeBitRate(f.MaximumBitRateForDownlink)
+	ugbr, ugbrExt, ugbrExt2 := encodeBitRate(f.GuaranteedBitRateForUplink)
+	dgbr, dgbrExt, dgbrExt2 := encodeBitRate(f.GuaranteedBitRateForDownlink)
+
+	b[0] = f.ARP
+	b[1] = (f.DelayClass&0x07)<<3 | f.ReliabilityClass&0x07
+	b[2] = (f.PeakThroughput&0x0f)<<4 | f.PrecedenceClass&0x07
+	b[3] = f.MeanThroughput & 0x1f
+	b[4] = (f.TrafficClass&0x07)<<5 | (f.DeliveryOrder&0x03)<<3 | f.DeliveryOfErroneousSDU&0x07
+	b[5] = f.MaximumSDUSize
+	b[6] = umbr
+	b[7] = dmbr
+	b[8] = (f.ResidualBER&0x0f)<<4 | f.SDUErrorRatio&0x0f
+	b[9] = (f.TransferDelay&0x3f)<<2 | f.TrafficHandlingPriority&0x03
+	b[10] = ugbr
+	b[11] = dgbr
+	if l == 12 {
+		return nil
+	}
+
+	b[12] = (f.SignallingIndication&0x01)<<4 | f.SourceStatisticsDescriptor&0x0f
+	if l == 13 {
+		return nil
+	}
+
+	b[13] = dmbrExt
+	b[14] = dgbrExt
+	if l == 15 {
+		return nil
+	}
+
+	b[15] = umbrExt
+	b[16] = ugbrExt
+	if l == 17 {
+		return nil
+	}
+
+	b[17] = dmbrExt2
+	b[18] = dgbrExt2
+	if l == 19 {
+		return nil
+	}
+
+	b[19] = umbrExt2
+	b[20] = ugbrExt2
+	return nil
+}
+
+// ParseQoSProfileFields decodes QoSProfileFields.
+func ParseQoSProfileFields(b []byte) (*QoSProfileFields, error) {
+	f := &QoSProfileFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into QoSProfileFields.
+//
+// The fields that are not present in the given bytes are left zero.
+func (f *QoSProfileFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 4 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.ARP = b[0]
+	f.DelayClass = b[1] >> 3 & 0x07
+	f.ReliabilityClass = b[1] & 0x07
+	f.PeakThroughput = b[2] >> 4
+	f.PrecedenceClass = b[2] & 0x07
+	f.MeanThroughput = b[3] & 0x1f
+	if l < 12 {
+		return nil
+	}
+
+	// the rates are kept as octets until all the extended ones are available.
+	var umbr, dmbr, ugbr, dgbr [3]uint8
+
+	f.TrafficClass = b[4] >> 5
+	f.DeliveryOrder = b[4] >> 3 & 0x03
+	f.DeliveryOfErroneousSDU = b[4] & 0x07
+	f.MaximumSDUSize = b[5]
+	umbr[0] = b[6]
+	dmbr[0] = b[7]
+	f.ResidualBER = b[8] >> 4
+	f.SDUErrorRatio = b[8] & 0x0f
+	f.TransferDelay = b[9] >> 2
+	f.TrafficHandlingPriority = b[9] & 0x03
+	ugbr[0] = b[10]
+	dgbr[0] = b[11]
+
+	if l >= 13 {
+		f.SignallingIndication = b[12] >> 4 & 0x01
+		f.SourceStatisticsDescriptor = b[12] & 0x0f
+	}
+	if l >= 15 {
+		dmbr[1] = b[13]
+		dgbr[1] = b[14]
+	}
+	if l >= 17 {
+		umbr[1] = b[15]
+		ugbr[1] = b[16]
+	}
+	if l >= 19 {
+		dmbr[2] = b[17]
+		dgbr[2] = b[18]
+	}
+	if l >= 21 {
+		umbr[2] = b[19]
+		ugbr[2] = b[20]
+	}
+
+	f.MaximumBitRateForUplink = decodeBitRate(umbr)
+	f.MaximumBitRateForDownlink = decodeBitRate(dmbr)
+	f.GuaranteedBitRateForUplink = decodeBitRate(ugbr)
+	f.GuaranteedBitRateForDownlink = decodeBitRate(dgbr)
+
+	return nil
+}
+
+// MarshalLen returns the serial length of QoSProfileFields in int.
+func (f *QoSProfileFields) MarshalLen() int {
+	_, umbrExt, umbrExt2 := encodeBitRate(f.MaximumBitRateForUplink)
+	_, dmbrExt, dmbrExt2 := encodeBitRate(f.MaximumBitRateForDownlink)
+	_, ugbrExt, ugbrExt2 := encodeBitRate(f.GuaranteedBitRateForUplink)
+	_, dgbrExt, dgbrExt2 := encodeBitRate(f.GuaranteedBitRateForDownlink)
+
+	switch {
+	case umbrExt2 != 0 || ugbrExt2 != 0:
+		return 21
+	case dmbrExt2 != 0 || dgbrExt2 != 0:
+		return 19
+	case umbrExt != 0 || ugbrExt != 0:
+		return 17
+	case dmbrExt != 0 || dgbrExt != 0:
+		return 15
+	case f.SignallingIndication != 0 || f.SourceStatisticsDescriptor != 0:
+		return 13
+	default:
+		return 12
+	}
+}
+
+// encodeBitRate encodes the bit rate given in kbps into the octets of
+// the bit rate, the extended one and the extended-2 one.
+func encodeBitRate(kbps uint64) (rate, ext, ext2 uint8) {
+	switch {
+	case kbps == 0:
+		return 0xff, 0, 0
+	case kbps <= 63:
+		return uint8(kbps), 0, 0
+	case kbps <= 568:
+		return uint8(0x40 + (kbps-64)/8), 0, 0
+	case kbps <= 8640:
+		return uint8(0x80 + (kbps-576)/64), 0, 0
+	case kbps <= 16000:
+		return 0xfe, uint8((kbps - 8600) / 100), 0
+	case kbps <= 128000:
+		return 0xfe, uint8(0x4a + (kbps-16000)/1000), 0
+	case kbps <= 256000:
+		return 0xfe, uint8(0xba + (kbps-128000)/2000), 0
+	case kbps <= 500000:
+		return 0xfe, 0xfa, uint8((kbps - 256000) / 4000)
+	case kbps <= 1500000:
+		return 0xfe, 0xfa, uint8(0x3d + (kbps-500000)/10000)
+	case kbps <= 10000000:
+		return 0xfe, 0xfa, uint8(0xa1 + (kbps-1500000)/100000)
+	default:
+		return 0xfe, 0xfa, 0xf6
+	}
+}
+
+// decodeBitRate decodes the octets of the bit rate, the extended one and
+// the extended-2 one into the bit rate in kbps.
+func decodeBitRate(b [3]uint8) uint64 {
+	rate, ext, ext2 := uint64(b[0]), uint64(b[1]), uint64(b[2])
+
+	switch {
+	case ext2 != 0:
+		switch {
+		case ext2 <= 0x3d:
+			return 256000 + ext2*4000
+		case ext2 <= 0xa1:
+			return 500000 + (ext2-0x3d)*10000
+		case ext2 <= 0xf6:
+			return 1500000 + (ext2-0xa1)*100000
+		default:
+			return 10000000
+		}
+	case ext != 0:
+		switch {
+		case ext <= 0x4a:
+			return 8600 + ext*100
+		case ext <= 0xba:
+			return 16000 + (ext-0x4a)*1000
+		case ext <= 0xfa:
+			return 128000 + (ext-0xba)*2000
+		default:
+			return 256000
+		}
+	default:
+		switch {
+		case rate == 0x00 || rate == 0xff:
+			return 0
+		case rate <= 0x3f:
+			return rate
+		case rate <= 0x7f:
+			return 64 + (rate-0x40)*8
+		default:
+			return 576 + (rate-0x80)*64
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestQoSProfileFields(t *testing.T) {
+	cases := []struct {
+		description string
+		fields      *QoSProfileFields
+		length      int
+	}{
+		{
+			"R99",
+			&QoSProfileFields{
+				ARP:                          1,
+				TrafficClass:                 1,
+				MaximumBitRateForUplink:      63,
+				MaximumBitRateForDownlink:    568,
+				GuaranteedBitRateForUplink:   576,
+				GuaranteedBitRateForDownlink: 8640,
+			},
+			12,
+		}, {
+			"SourceStatisticsDescriptor",
+			&QoSProfileFields{
+				ARP:                        1,
+				TrafficClass:               1,
+				SignallingIndication:       1,
+				SourceStatisticsDescriptor: 1,
+			},
+			13,
+		}, {
+			"ExtendedDownlink",
+			&QoSProfileFields{
+				ARP:                          1,
+				MaximumBitRateForUplink:      8640,
+				MaximumBitRateForDownlink:    16000,
+				GuaranteedBitRateForDownlink: 8700,
+			},
+			15,
+		}, {
+			"ExtendedUplink",
+			&QoSProfileFields{
+				ARP:                        1,
+				MaximumBitRateForUplink:    128000,
+				MaximumBitRateForDownlink:  256000,
+				GuaranteedBitRateForUplink: 17000,
+			},
+			17,
+		}, {
+			"Extended2Downlink",
+			&QoSProfileFields{
+				ARP:                          1,
+				MaximumBitRateForUplink:      256000,
+				MaximumBitRateForDownlink:    500000,
+				GuaranteedBitRateForDownlink: 260000,
+			},
+			19,
+		}, {
+			"Extended2Uplink",
+			&QoSProfileFields{
+				ARP:                          1,
+				MaximumBitRateForUplink:      10000000,
+				MaximumBitRateForDownlink:    1500000,
+				GuaranteedBitRateForUplink:   1600000,
+				GuaranteedBitRateForDownlink: 510000,
+			},
+			21,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			i := NewQoSProfileByFields(c.fields)
+			if i == nil {
+				t.Fatal("got nil IE")
+			}
+			if l := len(i.Payload); l != c.length {
+				t.Errorf("wrong length, want %d, got %d", c.length, l)
+			}
+
+			b, err := i.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := Parse(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := parsed.QoSProfileFields()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, c.fields); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
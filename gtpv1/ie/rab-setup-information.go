@@ -0,0 +1,53 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"net"
+)
+
+// NewRABSetupInformation creates a new RABSetupInformation IE.
+//
+// If rncIP is empty, the IE contains NSAPI only, which indicates that the
+// corresponding RAB is to be released.
+func NewRABSetupInformation(nsapi uint8, teid uint32, rncIP string) *IE {
+	if rncIP == "" {
+		return New(RABSetupInformation, []byte{nsapi & 0x0f})
+	}
+
+	ip := net.ParseIP(rncIP)
+	if ip == nil {
+		return nil
+	}
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+
+	rab := New(
+		RABSetupInformation,
+		make([]byte, 5+len(ip)),
+	)
+	rab.Payload[0] = nsapi & 0x0f
+	binary.BigEndian.PutUint32(rab.Payload[1:5], teid)
+	copy(rab.Payload[5:], ip)
+
+	return rab
+}
+
+// RABSetupInformation returns RABSetupInformation value if type matches.
+func (i *IE) RABSetupInformation() ([]byte, error) {
+	if i.Type != RABSetupInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	return i.Payload, nil
+}
+
+// MustRABSetupInformation returns RABSetupInformation in []byte if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustRABSetupInformation() []byte {
+	v, _ := i.RABSetupInformation()
+	return v
+}
@@ -9,7 +9,7 @@ import "io"
 // RAC returns RAC value if type matches.
 func (i *IE) RAC() (uint8, error) {
 	switch i.Type {
-	case RouteingAreaIdentity:
+	case RouteingAreaIdentity, TargetIdentification:
 		if len(i.Payload) < 6 {
 			return 0, io.ErrUnexpectedEOF
 		}
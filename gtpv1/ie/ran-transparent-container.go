@@ -0,0 +1,25 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewRANTransparentContainer creates a new RANTransparentContainer IE.
+func NewRANTransparentContainer(container []byte) *IE {
+	return New(RANTransparentContainer, container)
+}
+
+// RANTransparentContainer returns RANTransparentContainer value if type matches.
+func (i *IE) RANTransparentContainer() ([]byte, error) {
+	if i.Type != RANTransparentContainer {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	return i.Payload, nil
+}
+
+// MustRANTransparentContainer returns RANTransparentContainer in []byte if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustRANTransparentContainer() []byte {
+	v, _ := i.RANTransparentContainer()
+	return v
+}
@@ -0,0 +1,100 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewTargetIdentification creates a new TargetIdentification IE.
+func NewTargetIdentification(mcc, mnc string, lac uint16, rac uint8, rncID uint16) *IE {
+	mc, err := utils.StrToSwappedBytes(mcc, "f")
+	if err != nil {
+		return nil
+	}
+	mn, err := utils.StrToSwappedBytes(mnc, "f")
+	if err != nil {
+		return nil
+	}
+
+	ti := New(
+		TargetIdentification,
+		make([]byte, 8),
+	)
+	copy(ti.Payload[0:2], mc)
+	ti.Payload[2] = mn[0]
+	binary.BigEndian.PutUint16(ti.Payload[3:5], lac)
+	ti.Payload[5] = rac
+	binary.BigEndian.PutUint16(ti.Payload[6:8], rncID)
+
+	return ti
+}
+
+// NewTargetIdentificationWithExtendedRNCID creates a new TargetIdentification IE
+// with Extended RNC-ID.
+func NewTargetIdentificationWithExtendedRNCID(mcc, mnc string, lac uint16, rac uint8, rncID, extendedRNCID uint16) *IE {
+	ti := NewTargetIdentification(mcc, mnc, lac, rac, rncID)
+	if ti == nil {
+		return nil
+	}
+
+	ti.Payload = append(ti.Payload, uint8(extendedRNCID>>8), uint8(extendedRNCID))
+	ti.SetLength()
+	return ti
+}
+
+// TargetIdentification returns TargetIdentification value if type matches.
+func (i *IE) TargetIdentification() ([]byte, error) {
+	if i.Type != TargetIdentification {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	return i.Payload, nil
+}
+
+// MustTargetIdentification returns TargetIdentification in []byte if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustTargetIdentification() []byte {
+	v, _ := i.TargetIdentification()
+	return v
+}
+
+// RNCID returns RNC-ID value if type matches.
+func (i *IE) RNCID() (uint16, error) {
+	if i.Type != TargetIdentification {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 8 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint16(i.Payload[6:8]), nil
+}
+
+// MustRNCID returns RNC-ID in uint16 if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustRNCID() uint16 {
+	v, _ := i.RNCID()
+	return v
+}
+
+// ExtendedRNCID returns Extended RNC-ID value if type matches.
+func (i *IE) ExtendedRNCID() (uint16, error) {
+	if i.Type != TargetIdentification {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 10 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return binary.BigEndian.Uint16(i.Payload[8:10]), nil
+}
+
+// MustExtendedRNCID returns Extended RNC-ID in uint16 if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustExtendedRNCID() uint16 {
+	v, _ := i.ExtendedRNCID()
+	return v
+}
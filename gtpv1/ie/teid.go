@@ -26,12 +26,17 @@ func NewTEIDDataII(teid uint32) *IE {
 
 // TEID returns TEID value if type matches.
 func (i *IE) TEID() (uint32, error) {
-	if len(i.Payload) < 4 {
-		return 0, io.ErrUnexpectedEOF
-	}
 	switch i.Type {
 	case TEIDCPlane, TEIDDataI, TEIDDataII:
+		if len(i.Payload) < 4 {
+			return 0, io.ErrUnexpectedEOF
+		}
 		return binary.BigEndian.Uint32(i.Payload), nil
+	case RABSetupInformation:
+		if len(i.Payload) < 5 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return binary.BigEndian.Uint32(i.Payload[1:5]), nil
 	default:
 		return 0, &InvalidTypeError{Type: i.Type}
 	}
@@ -0,0 +1,25 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewUTRANTransparentContainer creates a new UTRANTransparentContainer IE.
+func NewUTRANTransparentContainer(container []byte) *IE {
+	return New(UTRANTransparentContainer, container)
+}
+
+// UTRANTransparentContainer returns UTRANTransparentContainer value if type matches.
+func (i *IE) UTRANTransparentContainer() ([]byte, error) {
+	if i.Type != UTRANTransparentContainer {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	return i.Payload, nil
+}
+
+// MustUTRANTransparentContainer returns UTRANTransparentContainer in []byte if type matches.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustUTRANTransparentContainer() []byte {
+	v, _ := i.UTRANTransparentContainer()
+	return v
+}
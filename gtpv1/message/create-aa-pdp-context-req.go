@@ -0,0 +1,367 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// CreateAAPDPContextRequest is a CreateAAPDPContextRequest Header and its IEs above.
+type CreateAAPDPContextRequest struct {
+	*Header
+	RAI                       *ie.IE
+	Recovery                  *ie.IE
+	SelectionMode             *ie.IE
+	TEIDDataI                 *ie.IE
+	TEIDCPlane                *ie.IE
+	NSAPI                     *ie.IE
+	ChargingCharacteristics   *ie.IE
+	EndUserAddress            *ie.IE
+	APN                       *ie.IE
+	PCO                       *ie.IE
+	SGSNAddressForSignalling  *ie.IE
+	SGSNAddressForUserTraffic *ie.IE
+	QoSProfile                *ie.IE
+	RATType                   *ie.IE
+	UserLocationInformation   *ie.IE
+	PrivateExtension          *ie.IE
+	AdditionalIEs             []*ie.IE
+}
+
+// NewCreateAAPDPContextRequest creates a new GTPv1 CreateAAPDPContextRequest.
+func NewCreateAAPDPContextRequest(teid uint32, seq uint16, ies ...*ie.IE) *CreateAAPDPContextRequest {
+	c := &CreateAAPDPContextRequest{
+		Header: NewHeader(0x32, MsgTypeCreateAAPDPContextRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.RouteingAreaIdentity:
+			c.RAI = i
+		case ie.Recovery:
+			c.Recovery = i
+		case ie.SelectionMode:
+			c.SelectionMode = i
+		case ie.TEIDDataI:
+			c.TEIDDataI = i
+		case ie.TEIDCPlane:
+			c.TEIDCPlane = i
+		case ie.NSAPI:
+			c.NSAPI = i
+		case ie.ChargingCharacteristics:
+			c.ChargingCharacteristics = i
+		case ie.EndUserAddress:
+			c.EndUserAddress = i
+		case ie.AccessPointName:
+			c.APN = i
+		case ie.ProtocolConfigurationOptions:
+			c.PCO = i
+		case ie.GSNAddress:
+			if c.SGSNAddressForSignalling == nil {
+				c.SGSNAddressForSignalling = i
+			} else if c.SGSNAddressForUserTraffic == nil {
+				c.SGSNAddressForUserTraffic = i
+			} else {
+				c.AdditionalIEs = append(c.AdditionalIEs, i)
+			}
+		case ie.QoSProfile:
+			c.QoSProfile = i
+		case ie.RATType:
+			c.RATType = i
+		case ie.UserLocationInformation:
+			c.UserLocationInformation = i
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	c.SetLength()
+	return c
+}
+
+// Marshal returns the byte sequence generated from a CreateAAPDPContextRequest.
+func (c *CreateAAPDPContextRequest) Marshal() ([]byte, error) {
+	b := make([]byte, c.MarshalLen())
+	if err := c.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (c *CreateAAPDPContextRequest) MarshalTo(b []byte) error {
+	if len(b) < c.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	c.Header.Payload = make([]byte, c.MarshalLen()-c.Header.MarshalLen())
+
+	offset := 0
+	if ie := c.RAI; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.Recovery; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.SelectionMode; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.TEIDDataI; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.NSAPI; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.ChargingCharacteristics; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.EndUserAddress; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.APN; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.PCO; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.SGSNAddressForSignalling; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.SGSNAddressForUserTraffic; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.QoSProfile; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.RATType; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.UserLocationInformation; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(c.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	c.Header.SetLength()
+	return c.Header.MarshalTo(b)
+}
+
+// ParseCreateAAPDPContextRequest decodes a given byte sequence as a CreateAAPDPContextRequest.
+func ParseCreateAAPDPContextRequest(b []byte) (*CreateAAPDPContextRequest, error) {
+	c := &CreateAAPDPContextRequest{}
+	if err := c.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a CreateAAPDPContextRequest.
+func (c *CreateAAPDPContextRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	c.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(c.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(c.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.RouteingAreaIdentity:
+			c.RAI = i
+		case ie.Recovery:
+			c.Recovery = i
+		case ie.SelectionMode:
+			c.SelectionMode = i
+		case ie.TEIDDataI:
+			c.TEIDDataI = i
+		case ie.TEIDCPlane:
+			c.TEIDCPlane = i
+		case ie.NSAPI:
+			c.NSAPI = i
+		case ie.ChargingCharacteristics:
+			c.ChargingCharacteristics = i
+		case ie.EndUserAddress:
+			c.EndUserAddress = i
+		case ie.AccessPointName:
+			c.APN = i
+		case ie.ProtocolConfigurationOptions:
+			c.PCO = i
+		case ie.GSNAddress:
+			if c.SGSNAddressForSignalling == nil {
+				c.SGSNAddressForSignalling = i
+			} else if c.SGSNAddressForUserTraffic == nil {
+				c.SGSNAddressForUserTraffic = i
+			} else {
+				c.AdditionalIEs = append(c.AdditionalIEs, i)
+			}
+		case ie.QoSProfile:
+			c.QoSProfile = i
+		case ie.RATType:
+			c.RATType = i
+		case ie.UserLocationInformation:
+			c.UserLocationInformation = i
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (c *CreateAAPDPContextRequest) MarshalLen() int {
+	l := c.Header.MarshalLen() - len(c.Header.Payload)
+
+	if ie := c.RAI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.Recovery; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.SelectionMode; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.TEIDDataI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.NSAPI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.ChargingCharacteristics; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.EndUserAddress; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.APN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.PCO; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.SGSNAddressForSignalling; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.SGSNAddressForUserTraffic; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.QoSProfile; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.RATType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.UserLocationInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (c *CreateAAPDPContextRequest) SetLength() {
+	c.Length = uint16(c.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (c *CreateAAPDPContextRequest) MessageTypeName() string {
+	return "Create AA PDP Context Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (c *CreateAAPDPContextRequest) TEID() uint32 {
+	return c.Header.TEID
+}
@@ -0,0 +1,70 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv1"
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+	"github.com/wmnsk/go-gtp/gtpv1/testutils"
+)
+
+func TestCreateAAPDPContextRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewCreateAAPDPContextRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewRouteingAreaIdentity("123", "45", 0x1111, 0x22),
+				ie.NewSelectionMode(gtpv1.SelectionModeMSorNetworkProvidedAPNSubscribedVerified),
+				ie.NewTEIDDataI(0xdeadbeef),
+				ie.NewTEIDCPlane(0xdeadbeef),
+				ie.NewNSAPI(5),
+				ie.NewEndUserAddress("10.10.10.10"),
+				ie.NewAccessPointName("some.apn.example"),
+				ie.NewGSNAddress("1.1.1.1"),
+				ie.NewGSNAddress("2.2.2.2"),
+				ie.NewQoSProfile([]byte{0x00, 0x1b, 0x93, 0x1f, 0x73, 0x96, 0x97, 0x97, 0x44, 0xfb, 0x10, 0x10}),
+			),
+			Serialized: []byte{
+				// Header
+				0x32, 0x16, 0x00, 0x53, 0x11, 0x22, 0x33, 0x44,
+				0x00, 0x01, 0x00, 0x00,
+				// RAI
+				0x03, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22,
+				// SelectionMode
+				0x0f, 0xf0,
+				// TEIDDataI
+				0x10, 0xde, 0xad, 0xbe, 0xef,
+				// TEIDCPlane
+				0x11, 0xde, 0xad, 0xbe, 0xef,
+				// NSAPI
+				0x14, 0x05,
+				// EndUserAddress
+				0x80, 0x00, 0x06, 0xf1, 0x21, 0x0a, 0x0a, 0x0a, 0x0a,
+				// APN
+				0x83, 0x00, 0x11, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e, 0x07, 0x65, 0x78, 0x61,
+				0x6d, 0x70, 0x6c, 0x65,
+				// SGSNAddressForSignalling
+				0x85, 0x00, 0x04, 0x01, 0x01, 0x01, 0x01,
+				// SGSNAddressForUserTraffic
+				0x85, 0x00, 0x04, 0x02, 0x02, 0x02, 0x02,
+				// QoSProfile
+				0x87, 0x00, 0x0c, 0x00, 0x1b, 0x93, 0x1f, 0x73, 0x96, 0x97, 0x97, 0x44, 0xfb, 0x10, 0x10,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseCreateAAPDPContextRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,443 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// CreateMBMSContextRequest is a CreateMBMSContextRequest Header and its IEs above.
+type CreateMBMSContextRequest struct {
+	*Header
+	IMSI                     *ie.IE
+	RAI                      *ie.IE
+	Recovery                 *ie.IE
+	SelectionMode            *ie.IE
+	TEIDCPlane               *ie.IE
+	TraceReference           *ie.IE
+	TraceType                *ie.IE
+	EndUserAddress           *ie.IE
+	APN                      *ie.IE
+	SGSNAddressForSignalling *ie.IE
+	MSISDN                   *ie.IE
+	TriggerID                *ie.IE
+	OMCIdentity              *ie.IE
+	RATType                  *ie.IE
+	UserLocationInformation  *ie.IE
+	MSTimeZone               *ie.IE
+	IMEISV                   *ie.IE
+	MBMSPCO                  *ie.IE
+	AdditionalTraceInfo      *ie.IE
+	EnhancedNSAPI            *ie.IE
+	AdditionalMBMSTraceInfo  *ie.IE
+	PrivateExtension         *ie.IE
+	AdditionalIEs            []*ie.IE
+}
+
+// NewCreateMBMSContextRequest creates a new GTPv1 CreateMBMSContextRequest.
+func NewCreateMBMSContextRequest(teid uint32, seq uint16, ies ...*ie.IE) *CreateMBMSContextRequest {
+	c := &CreateMBMSContextRequest{
+		Header: NewHeader(0x32, MsgTypeCreateMBMSContextRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			c.IMSI = i
+		case ie.RouteingAreaIdentity:
+			c.RAI = i
+		case ie.Recovery:
+			c.Recovery = i
+		case ie.SelectionMode:
+			c.SelectionMode = i
+		case ie.TEIDCPlane:
+			c.TEIDCPlane = i
+		case ie.TraceReference:
+			c.TraceReference = i
+		case ie.TraceType:
+			c.TraceType = i
+		case ie.EndUserAddress:
+			c.EndUserAddress = i
+		case ie.AccessPointName:
+			c.APN = i
+		case ie.GSNAddress:
+			c.SGSNAddressForSignalling = i
+		case ie.MSISDN:
+			c.MSISDN = i
+		case ie.TriggerID:
+			c.TriggerID = i
+		case ie.OMCIdentity:
+			c.OMCIdentity = i
+		case ie.RATType:
+			c.RATType = i
+		case ie.UserLocationInformation:
+			c.UserLocationInformation = i
+		case ie.MSTimeZone:
+			c.MSTimeZone = i
+		case ie.IMEISV:
+			c.IMEISV = i
+		case ie.MBMSProtocolConfigurationOptions:
+			c.MBMSPCO = i
+		case ie.AdditionalTraceInfo:
+			c.AdditionalTraceInfo = i
+		case ie.EnhancedNSAPI:
+			c.EnhancedNSAPI = i
+		case ie.AdditionalMBMSTraceInfo:
+			c.AdditionalMBMSTraceInfo = i
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	c.SetLength()
+	return c
+}
+
+// Marshal returns the byte sequence generated from a CreateMBMSContextRequest.
+func (c *CreateMBMSContextRequest) Marshal() ([]byte, error) {
+	b := make([]byte, c.MarshalLen())
+	if err := c.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (c *CreateMBMSContextRequest) MarshalTo(b []byte) error {
+	if len(b) < c.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	c.Header.Payload = make([]byte, c.MarshalLen()-c.Header.MarshalLen())
+
+	offset := 0
+	if ie := c.IMSI; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.RAI; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.Recovery; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.SelectionMode; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.TraceReference; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.TraceType; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.EndUserAddress; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.APN; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.SGSNAddressForSignalling; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.MSISDN; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.TriggerID; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.OMCIdentity; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.RATType; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.UserLocationInformation; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.MSTimeZone; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.IMEISV; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.MBMSPCO; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.AdditionalTraceInfo; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.EnhancedNSAPI; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.AdditionalMBMSTraceInfo; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(c.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	c.Header.SetLength()
+	return c.Header.MarshalTo(b)
+}
+
+// ParseCreateMBMSContextRequest decodes a given byte sequence as a CreateMBMSContextRequest.
+func ParseCreateMBMSContextRequest(b []byte) (*CreateMBMSContextRequest, error) {
+	c := &CreateMBMSContextRequest{}
+	if err := c.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a CreateMBMSContextRequest.
+func (c *CreateMBMSContextRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	c.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(c.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(c.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			c.IMSI = i
+		case ie.RouteingAreaIdentity:
+			c.RAI = i
+		case ie.Recovery:
+			c.Recovery = i
+		case ie.SelectionMode:
+			c.SelectionMode = i
+		case ie.TEIDCPlane:
+			c.TEIDCPlane = i
+		case ie.TraceReference:
+			c.TraceReference = i
+		case ie.TraceType:
+			c.TraceType = i
+		case ie.EndUserAddress:
+			c.EndUserAddress = i
+		case ie.AccessPointName:
+			c.APN = i
+		case ie.GSNAddress:
+			c.SGSNAddressForSignalling = i
+		case ie.MSISDN:
+			c.MSISDN = i
+		case ie.TriggerID:
+			c.TriggerID = i
+		case ie.OMCIdentity:
+			c.OMCIdentity = i
+		case ie.RATType:
+			c.RATType = i
+		case ie.UserLocationInformation:
+			c.UserLocationInformation = i
+		case ie.MSTimeZone:
+			c.MSTimeZone = i
+		case ie.IMEISV:
+			c.IMEISV = i
+		case ie.MBMSProtocolConfigurationOptions:
+			c.MBMSPCO = i
+		case ie.AdditionalTraceInfo:
+			c.AdditionalTraceInfo = i
+		case ie.EnhancedNSAPI:
+			c.EnhancedNSAPI = i
+		case ie.AdditionalMBMSTraceInfo:
+			c.AdditionalMBMSTraceInfo = i
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (c *CreateMBMSContextRequest) MarshalLen() int {
+	l := c.Header.MarshalLen() - len(c.Header.Payload)
+
+	if ie := c.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.RAI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.Recovery; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.SelectionMode; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.TraceReference; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.TraceType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.EndUserAddress; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.APN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.SGSNAddressForSignalling; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.MSISDN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.TriggerID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.OMCIdentity; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.RATType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.UserLocationInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.MSTimeZone; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.IMEISV; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.MBMSPCO; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.AdditionalTraceInfo; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.EnhancedNSAPI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.AdditionalMBMSTraceInfo; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (c *CreateMBMSContextRequest) SetLength() {
+	c.Length = uint16(c.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (c *CreateMBMSContextRequest) MessageTypeName() string {
+	return "Create MBMS Context Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (c *CreateMBMSContextRequest) TEID() uint32 {
+	return c.Header.TEID
+}
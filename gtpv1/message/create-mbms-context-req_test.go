@@ -0,0 +1,67 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv1"
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+	"github.com/wmnsk/go-gtp/gtpv1/testutils"
+)
+
+func TestCreateMBMSContextRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewCreateMBMSContextRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123450123456789"),
+				ie.NewRouteingAreaIdentity("123", "45", 0x1111, 0x22),
+				ie.NewSelectionMode(gtpv1.SelectionModeMSorNetworkProvidedAPNSubscribedVerified),
+				ie.NewTEIDCPlane(0xdeadbeef),
+				ie.NewEndUserAddress("239.0.0.1"),
+				ie.NewAccessPointName("some.apn.example"),
+				ie.NewGSNAddress("1.1.1.1"),
+				ie.NewRATType(gtpv1.RatTypeUTRAN),
+				ie.New(ie.EnhancedNSAPI, []byte{0x80}),
+			),
+			Serialized: []byte{
+				// Header
+				0x32, 0x64, 0x00, 0x47, 0x11, 0x22, 0x33, 0x44,
+				0x00, 0x01, 0x00, 0x00,
+				// IMSI
+				0x02, 0x21, 0x43, 0x05, 0x21, 0x43, 0x65, 0x87, 0xf9,
+				// RAI
+				0x03, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22,
+				// SelectionMode
+				0x0f, 0xf0,
+				// TEIDCPlane
+				0x11, 0xde, 0xad, 0xbe, 0xef,
+				// EndUserAddress
+				0x80, 0x00, 0x06, 0xf1, 0x21, 0xef, 0x00, 0x00, 0x01,
+				// APN
+				0x83, 0x00, 0x11, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e, 0x07, 0x65, 0x78, 0x61,
+				0x6d, 0x70, 0x6c, 0x65,
+				// SGSNAddressForSignalling
+				0x85, 0x00, 0x04, 0x01, 0x01, 0x01, 0x01,
+				// RATType
+				0x97, 0x00, 0x01, 0x01,
+				// EnhancedNSAPI
+				0xa7, 0x00, 0x01, 0x80,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseCreateMBMSContextRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,241 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// CreateMBMSContextResponse is a CreateMBMSContextResponse Header and its IEs above.
+type CreateMBMSContextResponse struct {
+	*Header
+	Cause                                 *ie.IE
+	Recovery                              *ie.IE
+	TEIDCPlane                            *ie.IE
+	GGSNAddressForControlPlane            *ie.IE
+	AlternativeGGSNAddressForControlPlane *ie.IE
+	MBMSPCO                               *ie.IE
+	PrivateExtension                      *ie.IE
+	AdditionalIEs                         []*ie.IE
+}
+
+// NewCreateMBMSContextResponse creates a new GTPv1 CreateMBMSContextResponse.
+func NewCreateMBMSContextResponse(teid uint32, seq uint16, ies ...*ie.IE) *CreateMBMSContextResponse {
+	c := &CreateMBMSContextResponse{
+		Header: NewHeader(0x32, MsgTypeCreateMBMSContextResponse, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			c.Cause = i
+		case ie.Recovery:
+			c.Recovery = i
+		case ie.TEIDCPlane:
+			c.TEIDCPlane = i
+		case ie.GSNAddress:
+			if c.GGSNAddressForControlPlane == nil {
+				c.GGSNAddressForControlPlane = i
+			} else if c.AlternativeGGSNAddressForControlPlane == nil {
+				c.AlternativeGGSNAddressForControlPlane = i
+			} else {
+				c.AdditionalIEs = append(c.AdditionalIEs, i)
+			}
+		case ie.MBMSProtocolConfigurationOptions:
+			c.MBMSPCO = i
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	c.SetLength()
+	return c
+}
+
+// Marshal returns the byte sequence generated from a CreateMBMSContextResponse.
+func (c *CreateMBMSContextResponse) Marshal() ([]byte, error) {
+	b := make([]byte, c.MarshalLen())
+	if err := c.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (c *CreateMBMSContextResponse) MarshalTo(b []byte) error {
+	if len(b) < c.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	c.Header.Payload = make([]byte, c.MarshalLen()-c.Header.MarshalLen())
+
+	offset := 0
+	if ie := c.Cause; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.Recovery; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.GGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.AlternativeGGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.MBMSPCO; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(c.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	c.Header.SetLength()
+	return c.Header.MarshalTo(b)
+}
+
+// ParseCreateMBMSContextResponse decodes a given byte sequence as a CreateMBMSContextResponse.
+func ParseCreateMBMSContextResponse(b []byte) (*CreateMBMSContextResponse, error) {
+	c := &CreateMBMSContextResponse{}
+	if err := c.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a CreateMBMSContextResponse.
+func (c *CreateMBMSContextResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	c.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(c.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(c.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			c.Cause = i
+		case ie.Recovery:
+			c.Recovery = i
+		case ie.TEIDCPlane:
+			c.TEIDCPlane = i
+		case ie.GSNAddress:
+			if c.GGSNAddressForControlPlane == nil {
+				c.GGSNAddressForControlPlane = i
+			} else if c.AlternativeGGSNAddressForControlPlane == nil {
+				c.AlternativeGGSNAddressForControlPlane = i
+			} else {
+				c.AdditionalIEs = append(c.AdditionalIEs, i)
+			}
+		case ie.MBMSProtocolConfigurationOptions:
+			c.MBMSPCO = i
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (c *CreateMBMSContextResponse) MarshalLen() int {
+	l := c.Header.MarshalLen() - len(c.Header.Payload)
+
+	if ie := c.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.Recovery; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.GGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.AlternativeGGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.MBMSPCO; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (c *CreateMBMSContextResponse) SetLength() {
+	c.Length = uint16(c.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (c *CreateMBMSContextResponse) MessageTypeName() string {
+	return "Create MBMS Context Response"
+}
+
+// TEID returns the TEID in human-readable string.
+func (c *CreateMBMSContextResponse) TEID() uint32 {
+	return c.Header.TEID
+}
@@ -0,0 +1,44 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+	"github.com/wmnsk/go-gtp/gtpv1/testutils"
+)
+
+func TestDataRecordTransferRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewDataRecordTransferRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.New(ie.PacketTransferCommand, []byte{0x01}),
+				ie.New(ie.DataRecordPacket, []byte{0x01, 0x01, 0x00, 0x01, 0x00, 0xff}),
+			),
+			Serialized: []byte{
+				// Header
+				0x32, 0xf0, 0x00, 0x0f, 0x11, 0x22, 0x33, 0x44,
+				0x00, 0x01, 0x00, 0x00,
+				// PacketTransferCommand
+				0x7e, 0x01,
+				// DataRecordPacket
+				0xfc, 0x00, 0x06, 0x01, 0x01, 0x00, 0x01, 0x00, 0xff,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseDataRecordTransferRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,205 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// DeleteMBMSContextRequest is a DeleteMBMSContextRequest Header and its IEs above.
+type DeleteMBMSContextRequest struct {
+	*Header
+	EndUserAddress   *ie.IE
+	APN              *ie.IE
+	MBMSPCO          *ie.IE
+	EnhancedNSAPI    *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewDeleteMBMSContextRequest creates a new GTPv1 DeleteMBMSContextRequest.
+func NewDeleteMBMSContextRequest(teid uint32, seq uint16, ies ...*ie.IE) *DeleteMBMSContextRequest {
+	d := &DeleteMBMSContextRequest{
+		Header: NewHeader(0x32, MsgTypeDeleteMBMSContextRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.EndUserAddress:
+			d.EndUserAddress = i
+		case ie.AccessPointName:
+			d.APN = i
+		case ie.MBMSProtocolConfigurationOptions:
+			d.MBMSPCO = i
+		case ie.EnhancedNSAPI:
+			d.EnhancedNSAPI = i
+		case ie.PrivateExtension:
+			d.PrivateExtension = i
+		default:
+			d.AdditionalIEs = append(d.AdditionalIEs, i)
+		}
+	}
+
+	d.SetLength()
+	return d
+}
+
+// Marshal returns the byte sequence generated from a DeleteMBMSContextRequest.
+func (d *DeleteMBMSContextRequest) Marshal() ([]byte, error) {
+	b := make([]byte, d.MarshalLen())
+	if err := d.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (d *DeleteMBMSContextRequest) MarshalTo(b []byte) error {
+	if len(b) < d.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	d.Header.Payload = make([]byte, d.MarshalLen()-d.Header.MarshalLen())
+
+	offset := 0
+	if ie := d.EndUserAddress; ie != nil {
+		if err := ie.MarshalTo(d.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := d.APN; ie != nil {
+		if err := ie.MarshalTo(d.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := d.MBMSPCO; ie != nil {
+		if err := ie.MarshalTo(d.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := d.EnhancedNSAPI; ie != nil {
+		if err := ie.MarshalTo(d.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := d.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(d.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range d.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(d.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	d.Header.SetLength()
+	return d.Header.MarshalTo(b)
+}
+
+// ParseDeleteMBMSContextRequest decodes a given byte sequence as a DeleteMBMSContextRequest.
+func ParseDeleteMBMSContextRequest(b []byte) (*DeleteMBMSContextRequest, error) {
+	d := &DeleteMBMSContextRequest{}
+	if err := d.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a DeleteMBMSContextRequest.
+func (d *DeleteMBMSContextRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	d.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(d.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(d.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.EndUserAddress:
+			d.EndUserAddress = i
+		case ie.AccessPointName:
+			d.APN = i
+		case ie.MBMSProtocolConfigurationOptions:
+			d.MBMSPCO = i
+		case ie.EnhancedNSAPI:
+			d.EnhancedNSAPI = i
+		case ie.PrivateExtension:
+			d.PrivateExtension = i
+		default:
+			d.AdditionalIEs = append(d.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (d *DeleteMBMSContextRequest) MarshalLen() int {
+	l := d.Header.MarshalLen() - len(d.Header.Payload)
+
+	if ie := d.EndUserAddress; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := d.APN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := d.MBMSPCO; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := d.EnhancedNSAPI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := d.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range d.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (d *DeleteMBMSContextRequest) SetLength() {
+	d.Length = uint16(d.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (d *DeleteMBMSContextRequest) MessageTypeName() string {
+	return "Delete MBMS Context Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (d *DeleteMBMSContextRequest) TEID() uint32 {
+	return d.Header.TEID
+}
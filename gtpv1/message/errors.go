@@ -4,7 +4,10 @@
 
 package message
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Error definitions.
 var (
@@ -13,3 +16,26 @@ var (
 	ErrTooShortToParse    = errors.New("too short to decode as GTPv1")
 	ErrInvalidMessageType = errors.New("got invalid message type")
 )
+
+// InvalidExtensionHeaderLengthError indicates the length of ExtensionHeader cannot be
+// represented in the Length field, i.e., it is not a multiple of 4 octets or too long.
+type InvalidExtensionHeaderLengthError struct {
+	Type   uint8
+	Length int
+}
+
+// Error returns message with the type and invalid length given.
+func (e *InvalidExtensionHeaderLengthError) Error() string {
+	return fmt.Sprintf("got invalid length of extension header %#x: %d", e.Type, e.Length)
+}
+
+// InvalidExtensionHeaderTypeError indicates the type of ExtensionHeader is invalid
+// for the operation.
+type InvalidExtensionHeaderTypeError struct {
+	Type uint8
+}
+
+// Error returns message with the invalid type given.
+func (e *InvalidExtensionHeaderTypeError) Error() string {
+	return fmt.Sprintf("got invalid extension header type: %#x", e.Type)
+}
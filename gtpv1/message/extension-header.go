@@ -0,0 +1,223 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Extension Header Type definitions.
+const (
+	ExtHeaderTypeNoMoreExtensionHeaders uint8 = 0x00
+	ExtHeaderTypeServiceClassIndicator  uint8 = 0x20
+	ExtHeaderTypeUDPPort                uint8 = 0x40
+	ExtHeaderTypeRANContainer           uint8 = 0x81
+	ExtHeaderTypeLongPDCPPDUNumber      uint8 = 0x82
+	ExtHeaderTypeXwRANContainer         uint8 = 0x83
+	ExtHeaderTypeNRRANContainer         uint8 = 0x84
+	ExtHeaderTypePDUSessionContainer    uint8 = 0x85
+	ExtHeaderTypePDCPPDUNumber          uint8 = 0xc0
+)
+
+// maxExtensionHeaderLen is the maximum length of Extension Header, as the Length
+// field is 1 octet long in 4-octet units.
+const maxExtensionHeaderLen = 0xff * 4
+
+// PDU Type definitions used in PDU Session Container, defined in TS 38.415.
+const (
+	PDUTypeDLPDUSessionInformation uint8 = 0
+	PDUTypeULPDUSessionInformation uint8 = 1
+)
+
+// ExtensionHeader is a GTPv1-U Extension Header.
+//
+// Content does not include the Length and Next Extension Header Type fields,
+// which are handled by Header when marshalling and parsing.
+type ExtensionHeader struct {
+	Type    uint8
+	Content []byte
+}
+
+// NewExtensionHeader creates a new ExtensionHeader.
+//
+// Content is padded with zeros so that the Extension Header ends at a 4-octet
+// boundary, as required by TS 29.281. Content longer than 1018 octets cannot be
+// marshalled, and Header returns InvalidExtensionHeaderLengthError for it.
+func NewExtensionHeader(typ uint8, content []byte) *ExtensionHeader {
+	l := len(content) + 2
+	if l%4 != 0 {
+		l += 4 - l%4
+	}
+
+	e := &ExtensionHeader{
+		Type:    typ,
+		Content: make([]byte, l-2),
+	}
+	copy(e.Content, content)
+	return e
+}
+
+// NewPDUSessionContainerExtensionHeader creates a new PDU Session Container
+// Extension Header with the minimum set of fields defined in TS 38.415.
+//
+// RQI is only meaningful in DL PDU SESSION INFORMATION and is ignored otherwise.
+func NewPDUSessionContainerExtensionHeader(pduType, qfi uint8, rqi bool) *ExtensionHeader {
+	b := []byte{(pduType & 0x0f) << 4, qfi & 0x3f}
+	if rqi && pduType == PDUTypeDLPDUSessionInformation {
+		b[1] |= 0x40
+	}
+	return NewExtensionHeader(ExtHeaderTypePDUSessionContainer, b)
+}
+
+// NewPDCPPDUNumberExtensionHeader creates a new PDCP PDU Number Extension Header.
+func NewPDCPPDUNumberExtensionHeader(num uint16) *ExtensionHeader {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, num)
+	return NewExtensionHeader(ExtHeaderTypePDCPPDUNumber, b)
+}
+
+// NewLongPDCPPDUNumberExtensionHeader creates a new Long PDCP PDU Number Extension Header.
+//
+// Only the lower 18 bits of num are used.
+func NewLongPDCPPDUNumberExtensionHeader(num uint32) *ExtensionHeader {
+	b := make([]byte, 6)
+	b[0] = uint8((num >> 16) & 0x03)
+	b[1] = uint8(num >> 8)
+	b[2] = uint8(num)
+	return NewExtensionHeader(ExtHeaderTypeLongPDCPPDUNumber, b)
+}
+
+// NewUDPPortExtensionHeader creates a new UDP Port Extension Header.
+func NewUDPPortExtensionHeader(port uint16) *ExtensionHeader {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, port)
+	return NewExtensionHeader(ExtHeaderTypeUDPPort, b)
+}
+
+// NewServiceClassIndicatorExtensionHeader creates a new Service Class Indicator Extension Header.
+func NewServiceClassIndicatorExtensionHeader(sci uint8) *ExtensionHeader {
+	return NewExtensionHeader(ExtHeaderTypeServiceClassIndicator, []byte{sci, 0x00})
+}
+
+// NewRANContainerExtensionHeader creates a new RAN Container Extension Header.
+func NewRANContainerExtensionHeader(container []byte) *ExtensionHeader {
+	return NewExtensionHeader(ExtHeaderTypeRANContainer, container)
+}
+
+// MarshalLen returns the serial length of ExtensionHeader, including the Length
+// and Next Extension Header Type fields.
+func (e *ExtensionHeader) MarshalLen() int {
+	return len(e.Content) + 2
+}
+
+// validate checks if the length of ExtensionHeader can be represented in the Length field.
+func (e *ExtensionHeader) validate() error {
+	l := e.MarshalLen()
+	if l%4 != 0 || l > maxExtensionHeaderLen {
+		return &InvalidExtensionHeaderLengthError{Type: e.Type, Length: l}
+	}
+	return nil
+}
+
+// PDUType returns PDU Type in uint8 if the type of ExtensionHeader is PDU Session Container.
+func (e *ExtensionHeader) PDUType() (uint8, error) {
+	if e.Type != ExtHeaderTypePDUSessionContainer {
+		return 0, &InvalidExtensionHeaderTypeError{Type: e.Type}
+	}
+	if len(e.Content) < 1 {
+		return 0, ErrTooShortToParse
+	}
+	return e.Content[0] >> 4, nil
+}
+
+// QFI returns QoS Flow Identifier in uint8 if the type of ExtensionHeader is PDU Session Container.
+func (e *ExtensionHeader) QFI() (uint8, error) {
+	if e.Type != ExtHeaderTypePDUSessionContainer {
+		return 0, &InvalidExtensionHeaderTypeError{Type: e.Type}
+	}
+	if len(e.Content) < 2 {
+		return 0, ErrTooShortToParse
+	}
+	return e.Content[1] & 0x3f, nil
+}
+
+// RQI reports whether Reflective QoS Indicator is set if the type of ExtensionHeader
+// is PDU Session Container. It is always false in UL PDU SESSION INFORMATION.
+func (e *ExtensionHeader) RQI() (bool, error) {
+	pduType, err := e.PDUType()
+	if err != nil {
+		return false, err
+	}
+	if len(e.Content) < 2 {
+		return false, ErrTooShortToParse
+	}
+	if pduType != PDUTypeDLPDUSessionInformation {
+		return false, nil
+	}
+	return e.Content[1]&0x40 != 0, nil
+}
+
+// PDCPPDUNumber returns PDCP PDU Number in uint16 if the type of ExtensionHeader is PDCP PDU Number.
+func (e *ExtensionHeader) PDCPPDUNumber() (uint16, error) {
+	if e.Type != ExtHeaderTypePDCPPDUNumber {
+		return 0, &InvalidExtensionHeaderTypeError{Type: e.Type}
+	}
+	if len(e.Content) < 2 {
+		return 0, ErrTooShortToParse
+	}
+	return binary.BigEndian.Uint16(e.Content[0:2]), nil
+}
+
+// LongPDCPPDUNumber returns Long PDCP PDU Number in uint32 if the type of ExtensionHeader
+// is Long PDCP PDU Number.
+func (e *ExtensionHeader) LongPDCPPDUNumber() (uint32, error) {
+	if e.Type != ExtHeaderTypeLongPDCPPDUNumber {
+		return 0, &InvalidExtensionHeaderTypeError{Type: e.Type}
+	}
+	if len(e.Content) < 3 {
+		return 0, ErrTooShortToParse
+	}
+	return uint32(e.Content[0]&0x03)<<16 | uint32(e.Content[1])<<8 | uint32(e.Content[2]), nil
+}
+
+// UDPPort returns UDP Port in uint16 if the type of ExtensionHeader is UDP Port.
+func (e *ExtensionHeader) UDPPort() (uint16, error) {
+	if e.Type != ExtHeaderTypeUDPPort {
+		return 0, &InvalidExtensionHeaderTypeError{Type: e.Type}
+	}
+	if len(e.Content) < 2 {
+		return 0, ErrTooShortToParse
+	}
+	return binary.BigEndian.Uint16(e.Content[0:2]), nil
+}
+
+// ServiceClassIndicator returns Service Class Indicator in uint8 if the type of
+// ExtensionHeader is Service Class Indicator.
+func (e *ExtensionHeader) ServiceClassIndicator() (uint8, error) {
+	if e.Type != ExtHeaderTypeServiceClassIndicator {
+		return 0, &InvalidExtensionHeaderTypeError{Type: e.Type}
+	}
+	if len(e.Content) < 1 {
+		return 0, ErrTooShortToParse
+	}
+	return e.Content[0], nil
+}
+
+// RANContainer returns the content of RAN Container in []byte if the type of
+// ExtensionHeader is RAN Container.
+//
+// The returned value may contain the padding added to align the Extension Header.
+func (e *ExtensionHeader) RANContainer() ([]byte, error) {
+	if e.Type != ExtHeaderTypeRANContainer {
+		return nil, &InvalidExtensionHeaderTypeError{Type: e.Type}
+	}
+	return e.Content, nil
+}
+
+// String returns the ExtensionHeader values in human readable format.
+func (e *ExtensionHeader) String() string {
+	return fmt.Sprintf("{Type: %#x, Content: %#v}", e.Type, e.Content)
+}
@@ -0,0 +1,88 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+)
+
+func TestExtensionHeader(t *testing.T) {
+	t.Run("PDUSessionContainer", func(t *testing.T) {
+		for _, c := range []struct {
+			pduType, qfi uint8
+			rqi          bool
+			wantRQI      bool
+		}{
+			{message.PDUTypeDLPDUSessionInformation, 9, true, true},
+			{message.PDUTypeDLPDUSessionInformation, 63, false, false},
+			{message.PDUTypeULPDUSessionInformation, 1, true, false},
+		} {
+			e := message.NewPDUSessionContainerExtensionHeader(c.pduType, c.qfi, c.rqi)
+			if e.MarshalLen()%4 != 0 {
+				t.Errorf("not aligned to 4 octets: %d", e.MarshalLen())
+			}
+			if got, err := e.PDUType(); err != nil || got != c.pduType {
+				t.Errorf("wrong PDU Type. want %d, got: %d, %v", c.pduType, got, err)
+			}
+			if got, err := e.QFI(); err != nil || got != c.qfi {
+				t.Errorf("wrong QFI. want %d, got: %d, %v", c.qfi, got, err)
+			}
+			if got, err := e.RQI(); err != nil || got != c.wantRQI {
+				t.Errorf("wrong RQI. want %v, got: %v, %v", c.wantRQI, got, err)
+			}
+		}
+	})
+
+	t.Run("Others", func(t *testing.T) {
+		if got, err := message.NewPDCPPDUNumberExtensionHeader(0x1234).PDCPPDUNumber(); err != nil || got != 0x1234 {
+			t.Errorf("wrong PDCP PDU Number: %#x, %v", got, err)
+		}
+		if got, err := message.NewLongPDCPPDUNumberExtensionHeader(0x2abcd).LongPDCPPDUNumber(); err != nil || got != 0x2abcd {
+			t.Errorf("wrong Long PDCP PDU Number: %#x, %v", got, err)
+		}
+		if got, err := message.NewUDPPortExtensionHeader(2152).UDPPort(); err != nil || got != 2152 {
+			t.Errorf("wrong UDP Port: %d, %v", got, err)
+		}
+		if got, err := message.NewServiceClassIndicatorExtensionHeader(0x80).ServiceClassIndicator(); err != nil || got != 0x80 {
+			t.Errorf("wrong Service Class Indicator: %#x, %v", got, err)
+		}
+	})
+
+	t.Run("InvalidLength", func(t *testing.T) {
+		for _, c := range []struct {
+			description string
+			eh          *message.ExtensionHeader
+			wantErr     bool
+		}{
+			{"Misaligned", &message.ExtensionHeader{Type: message.ExtHeaderTypeRANContainer, Content: []byte{0x01, 0x02, 0x03}}, true},
+			{"TooLong", message.NewRANContainerExtensionHeader(make([]byte, 1019)), true},
+			{"Longest", message.NewRANContainerExtensionHeader(make([]byte, 1018)), false},
+		} {
+			h := message.NewHeader(message.NewHeaderFlags(1, 1, 0, 1, 0), 0xff, 0xdeadbeef, 0, nil)
+			h.AddExtensionHeaders(c.eh)
+
+			_, err := h.Marshal()
+			if !c.wantErr {
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", c.description, err)
+				}
+				continue
+			}
+			var lenErr *message.InvalidExtensionHeaderLengthError
+			if !errors.As(err, &lenErr) {
+				t.Errorf("%s: expected InvalidExtensionHeaderLengthError, got: %v", c.description, err)
+			}
+		}
+	})
+
+	t.Run("InvalidType", func(t *testing.T) {
+		if _, err := message.NewUDPPortExtensionHeader(2152).QFI(); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
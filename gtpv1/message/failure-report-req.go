@@ -0,0 +1,163 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// FailureReportRequest is a FailureReportRequest Header and its IEs above.
+type FailureReportRequest struct {
+	*Header
+	IMSI             *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewFailureReportRequest creates a new GTPv1 FailureReportRequest.
+func NewFailureReportRequest(teid uint32, seq uint16, ies ...*ie.IE) *FailureReportRequest {
+	f := &FailureReportRequest{
+		Header: NewHeader(0x32, MsgTypeFailureReportRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			f.IMSI = i
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	f.SetLength()
+	return f
+}
+
+// Marshal returns the byte sequence generated from a FailureReportRequest.
+func (f *FailureReportRequest) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *FailureReportRequest) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	f.Header.Payload = make([]byte, f.MarshalLen()-f.Header.MarshalLen())
+
+	offset := 0
+	if ie := f.IMSI; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	f.Header.SetLength()
+	return f.Header.MarshalTo(b)
+}
+
+// ParseFailureReportRequest decodes a given byte sequence as a FailureReportRequest.
+func ParseFailureReportRequest(b []byte) (*FailureReportRequest, error) {
+	f := &FailureReportRequest{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a FailureReportRequest.
+func (f *FailureReportRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	f.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(f.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(f.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			f.IMSI = i
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (f *FailureReportRequest) MarshalLen() int {
+	l := f.Header.MarshalLen() - len(f.Header.Payload)
+
+	if ie := f.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (f *FailureReportRequest) SetLength() {
+	f.Length = uint16(f.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (f *FailureReportRequest) MessageTypeName() string {
+	return "Failure Report Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (f *FailureReportRequest) TEID() uint32 {
+	return f.Header.TEID
+}
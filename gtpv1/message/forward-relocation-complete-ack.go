@@ -0,0 +1,163 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// ForwardRelocationCompleteAcknowledge is a ForwardRelocationCompleteAcknowledge Header and its IEs above.
+type ForwardRelocationCompleteAcknowledge struct {
+	*Header
+	Cause            *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewForwardRelocationCompleteAcknowledge creates a new GTPv1 ForwardRelocationCompleteAcknowledge.
+func NewForwardRelocationCompleteAcknowledge(teid uint32, seq uint16, ies ...*ie.IE) *ForwardRelocationCompleteAcknowledge {
+	f := &ForwardRelocationCompleteAcknowledge{
+		Header: NewHeader(0x32, MsgTypeForwardRelocationCompleteAcknowledge, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			f.Cause = i
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	f.SetLength()
+	return f
+}
+
+// Marshal returns the byte sequence generated from a ForwardRelocationCompleteAcknowledge.
+func (f *ForwardRelocationCompleteAcknowledge) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *ForwardRelocationCompleteAcknowledge) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	f.Header.Payload = make([]byte, f.MarshalLen()-f.Header.MarshalLen())
+
+	offset := 0
+	if ie := f.Cause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	f.Header.SetLength()
+	return f.Header.MarshalTo(b)
+}
+
+// ParseForwardRelocationCompleteAcknowledge decodes a given byte sequence as a ForwardRelocationCompleteAcknowledge.
+func ParseForwardRelocationCompleteAcknowledge(b []byte) (*ForwardRelocationCompleteAcknowledge, error) {
+	f := &ForwardRelocationCompleteAcknowledge{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a ForwardRelocationCompleteAcknowledge.
+func (f *ForwardRelocationCompleteAcknowledge) UnmarshalBinary(b []byte) error {
+	var err error
+	f.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(f.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(f.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			f.Cause = i
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (f *ForwardRelocationCompleteAcknowledge) MarshalLen() int {
+	l := f.Header.MarshalLen() - len(f.Header.Payload)
+
+	if ie := f.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (f *ForwardRelocationCompleteAcknowledge) SetLength() {
+	f.Length = uint16(f.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (f *ForwardRelocationCompleteAcknowledge) MessageTypeName() string {
+	return "Forward Relocation Complete Acknowledge"
+}
+
+// TEID returns the TEID in human-readable string.
+func (f *ForwardRelocationCompleteAcknowledge) TEID() uint32 {
+	return f.Header.TEID
+}
@@ -0,0 +1,149 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// ForwardRelocationComplete is a ForwardRelocationComplete Header and its IEs above.
+type ForwardRelocationComplete struct {
+	*Header
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewForwardRelocationComplete creates a new GTPv1 ForwardRelocationComplete.
+func NewForwardRelocationComplete(teid uint32, seq uint16, ies ...*ie.IE) *ForwardRelocationComplete {
+	f := &ForwardRelocationComplete{
+		Header: NewHeader(0x32, MsgTypeForwardRelocationComplete, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	f.SetLength()
+	return f
+}
+
+// Marshal returns the byte sequence generated from a ForwardRelocationComplete.
+func (f *ForwardRelocationComplete) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *ForwardRelocationComplete) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	f.Header.Payload = make([]byte, f.MarshalLen()-f.Header.MarshalLen())
+
+	offset := 0
+	if ie := f.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	f.Header.SetLength()
+	return f.Header.MarshalTo(b)
+}
+
+// ParseForwardRelocationComplete decodes a given byte sequence as a ForwardRelocationComplete.
+func ParseForwardRelocationComplete(b []byte) (*ForwardRelocationComplete, error) {
+	f := &ForwardRelocationComplete{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a ForwardRelocationComplete.
+func (f *ForwardRelocationComplete) UnmarshalBinary(b []byte) error {
+	var err error
+	f.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(f.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(f.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (f *ForwardRelocationComplete) MarshalLen() int {
+	l := f.Header.MarshalLen() - len(f.Header.Payload)
+
+	if ie := f.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (f *ForwardRelocationComplete) SetLength() {
+	f.Length = uint16(f.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (f *ForwardRelocationComplete) MessageTypeName() string {
+	return "Forward Relocation Complete"
+}
+
+// TEID returns the TEID in human-readable string.
+func (f *ForwardRelocationComplete) TEID() uint32 {
+	return f.Header.TEID
+}
@@ -0,0 +1,731 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// ForwardRelocationRequest is a ForwardRelocationRequest Header and its IEs above.
+type ForwardRelocationRequest struct {
+	*Header
+	IMSI                                  *ie.IE
+	TEIDCPlane                            *ie.IE
+	RANAPCause                            *ie.IE
+	PacketFlowID                          *ie.IE
+	ChargingCharacteristics               *ie.IE
+	MMContext                             *ie.IE
+	PDPContext                            *ie.IE
+	SGSNAddressForControlPlane            *ie.IE
+	TargetIdentification                  *ie.IE
+	UTRANTransparentContainer             *ie.IE
+	PDPContextPrioritization              *ie.IE
+	MBMSUEContext                         *ie.IE
+	SelectedPLMNID                        *ie.IE
+	BSSContainer                          *ie.IE
+	CellIdentification                    *ie.IE
+	BSSGPCause                            *ie.IE
+	PSHandoverXIDParameters               *ie.IE
+	DirectTunnelFlags                     *ie.IE
+	ReliableInterRATHandoverInfo          *ie.IE
+	SubscribedRFSPIndex                   *ie.IE
+	RFSPIndexInUse                        *ie.IE
+	CoLocatedGGSNPGWFQDN                  *ie.IE
+	EvolvedARPII                          *ie.IE
+	ExtendedCommonFlags                   *ie.IE
+	CSGID                                 *ie.IE
+	CSGMembershipIndication               *ie.IE
+	UENetworkCapability                   *ie.IE
+	UEAMBR                                *ie.IE
+	APNAMBRWithNSAPI                      *ie.IE
+	SignallingPriorityIndicationWithNSAPI *ie.IE
+	HigherBitratesThan16MbpsFlag          *ie.IE
+	AdditionalMMContextForSRVCC           *ie.IE
+	AdditionalFlagsForSRVCC               *ie.IE
+	STNSR                                 *ie.IE
+	CMSISDN                               *ie.IE
+	ExtendedRANAPCause                    *ie.IE
+	ENodeBID                              *ie.IE
+	SelectionModeWithNSAPI                *ie.IE
+	UEUsageType                           *ie.IE
+	ExtendedCommonFlagsII                 *ie.IE
+	SCEFPDNConnection                     *ie.IE
+	PrivateExtension                      *ie.IE
+	AdditionalIEs                         []*ie.IE
+}
+
+// NewForwardRelocationRequest creates a new GTPv1 ForwardRelocationRequest.
+func NewForwardRelocationRequest(teid uint32, seq uint16, ies ...*ie.IE) *ForwardRelocationRequest {
+	f := &ForwardRelocationRequest{
+		Header: NewHeader(0x32, MsgTypeForwardRelocationRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			f.IMSI = i
+		case ie.TEIDCPlane:
+			f.TEIDCPlane = i
+		case ie.RANAPCause:
+			f.RANAPCause = i
+		case ie.PacketFlowID:
+			f.PacketFlowID = i
+		case ie.ChargingCharacteristics:
+			f.ChargingCharacteristics = i
+		case ie.MMContext:
+			f.MMContext = i
+		case ie.PDPContext:
+			f.PDPContext = i
+		case ie.GSNAddress:
+			f.SGSNAddressForControlPlane = i
+		case ie.TargetIdentification:
+			f.TargetIdentification = i
+		case ie.UTRANTransparentContainer:
+			f.UTRANTransparentContainer = i
+		case ie.PDPContextPrioritization:
+			f.PDPContextPrioritization = i
+		case ie.MBMSUEContext:
+			f.MBMSUEContext = i
+		case ie.SelectedPLMNID:
+			f.SelectedPLMNID = i
+		case ie.BSSContainer:
+			f.BSSContainer = i
+		case ie.CellIdentification:
+			f.CellIdentification = i
+		case ie.BSSGPCause:
+			f.BSSGPCause = i
+		case ie.PSHandoverXIDParameters:
+			f.PSHandoverXIDParameters = i
+		case ie.DirectTunnelFlags:
+			f.DirectTunnelFlags = i
+		case ie.ReliableInterRATHandoverInfo:
+			f.ReliableInterRATHandoverInfo = i
+		case ie.RFSPIndex:
+			if f.SubscribedRFSPIndex == nil {
+				f.SubscribedRFSPIndex = i
+			} else if f.RFSPIndexInUse == nil {
+				f.RFSPIndexInUse = i
+			} else {
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.FullyQualifiedDomainName:
+			f.CoLocatedGGSNPGWFQDN = i
+		case ie.EvolvedAllocationRetentionPriorityII:
+			f.EvolvedARPII = i
+		case ie.ExtendedCommonFlags:
+			f.ExtendedCommonFlags = i
+		case ie.CSGID:
+			f.CSGID = i
+		case ie.CSGMembershipIndication:
+			f.CSGMembershipIndication = i
+		case ie.UENetworkCapability:
+			f.UENetworkCapability = i
+		case ie.UEAMBR:
+			f.UEAMBR = i
+		case ie.APNAMBRWithNSAPI:
+			f.APNAMBRWithNSAPI = i
+		case ie.SignallingPriorityIndicationWithNSAPI:
+			f.SignallingPriorityIndicationWithNSAPI = i
+		case ie.HigherBitratesThan16MbpsFlag:
+			f.HigherBitratesThan16MbpsFlag = i
+		case ie.AdditionalMMContextForSRVCC:
+			f.AdditionalMMContextForSRVCC = i
+		case ie.AdditionalFlagsForSRVCC:
+			f.AdditionalFlagsForSRVCC = i
+		case ie.STNSR:
+			f.STNSR = i
+		case ie.CMSISDN:
+			f.CMSISDN = i
+		case ie.ExtendedRANAPCause:
+			f.ExtendedRANAPCause = i
+		case ie.ENodeBID:
+			f.ENodeBID = i
+		case ie.SelectionModeWithNSAPI:
+			f.SelectionModeWithNSAPI = i
+		case ie.UEUsageType:
+			f.UEUsageType = i
+		case ie.ExtendedCommonFlagsII:
+			f.ExtendedCommonFlagsII = i
+		case ie.SCEFPDNConnection:
+			f.SCEFPDNConnection = i
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	f.SetLength()
+	return f
+}
+
+// Marshal returns the byte sequence generated from a ForwardRelocationRequest.
+func (f *ForwardRelocationRequest) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *ForwardRelocationRequest) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	f.Header.Payload = make([]byte, f.MarshalLen()-f.Header.MarshalLen())
+
+	offset := 0
+	if ie := f.IMSI; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.RANAPCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PacketFlowID; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.ChargingCharacteristics; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MMContext; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PDPContext; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.TargetIdentification; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.UTRANTransparentContainer; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PDPContextPrioritization; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MBMSUEContext; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SelectedPLMNID; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.BSSContainer; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.CellIdentification; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.BSSGPCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PSHandoverXIDParameters; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.DirectTunnelFlags; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.ReliableInterRATHandoverInfo; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SubscribedRFSPIndex; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.RFSPIndexInUse; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.CoLocatedGGSNPGWFQDN; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.EvolvedARPII; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.ExtendedCommonFlags; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.CSGID; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.CSGMembershipIndication; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.UENetworkCapability; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.UEAMBR; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.APNAMBRWithNSAPI; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SignallingPriorityIndicationWithNSAPI; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.HigherBitratesThan16MbpsFlag; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.AdditionalMMContextForSRVCC; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.AdditionalFlagsForSRVCC; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.STNSR; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.CMSISDN; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.ExtendedRANAPCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.ENodeBID; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SelectionModeWithNSAPI; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.UEUsageType; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.ExtendedCommonFlagsII; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SCEFPDNConnection; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	f.Header.SetLength()
+	return f.Header.MarshalTo(b)
+}
+
+// ParseForwardRelocationRequest decodes a given byte sequence as a ForwardRelocationRequest.
+func ParseForwardRelocationRequest(b []byte) (*ForwardRelocationRequest, error) {
+	f := &ForwardRelocationRequest{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a ForwardRelocationRequest.
+func (f *ForwardRelocationRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	f.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(f.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(f.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			f.IMSI = i
+		case ie.TEIDCPlane:
+			f.TEIDCPlane = i
+		case ie.RANAPCause:
+			f.RANAPCause = i
+		case ie.PacketFlowID:
+			f.PacketFlowID = i
+		case ie.ChargingCharacteristics:
+			f.ChargingCharacteristics = i
+		case ie.MMContext:
+			f.MMContext = i
+		case ie.PDPContext:
+			f.PDPContext = i
+		case ie.GSNAddress:
+			f.SGSNAddressForControlPlane = i
+		case ie.TargetIdentification:
+			f.TargetIdentification = i
+		case ie.UTRANTransparentContainer:
+			f.UTRANTransparentContainer = i
+		case ie.PDPContextPrioritization:
+			f.PDPContextPrioritization = i
+		case ie.MBMSUEContext:
+			f.MBMSUEContext = i
+		case ie.SelectedPLMNID:
+			f.SelectedPLMNID = i
+		case ie.BSSContainer:
+			f.BSSContainer = i
+		case ie.CellIdentification:
+			f.CellIdentification = i
+		case ie.BSSGPCause:
+			f.BSSGPCause = i
+		case ie.PSHandoverXIDParameters:
+			f.PSHandoverXIDParameters = i
+		case ie.DirectTunnelFlags:
+			f.DirectTunnelFlags = i
+		case ie.ReliableInterRATHandoverInfo:
+			f.ReliableInterRATHandoverInfo = i
+		case ie.RFSPIndex:
+			if f.SubscribedRFSPIndex == nil {
+				f.SubscribedRFSPIndex = i
+			} else if f.RFSPIndexInUse == nil {
+				f.RFSPIndexInUse = i
+			} else {
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.FullyQualifiedDomainName:
+			f.CoLocatedGGSNPGWFQDN = i
+		case ie.EvolvedAllocationRetentionPriorityII:
+			f.EvolvedARPII = i
+		case ie.ExtendedCommonFlags:
+			f.ExtendedCommonFlags = i
+		case ie.CSGID:
+			f.CSGID = i
+		case ie.CSGMembershipIndication:
+			f.CSGMembershipIndication = i
+		case ie.UENetworkCapability:
+			f.UENetworkCapability = i
+		case ie.UEAMBR:
+			f.UEAMBR = i
+		case ie.APNAMBRWithNSAPI:
+			f.APNAMBRWithNSAPI = i
+		case ie.SignallingPriorityIndicationWithNSAPI:
+			f.SignallingPriorityIndicationWithNSAPI = i
+		case ie.HigherBitratesThan16MbpsFlag:
+			f.HigherBitratesThan16MbpsFlag = i
+		case ie.AdditionalMMContextForSRVCC:
+			f.AdditionalMMContextForSRVCC = i
+		case ie.AdditionalFlagsForSRVCC:
+			f.AdditionalFlagsForSRVCC = i
+		case ie.STNSR:
+			f.STNSR = i
+		case ie.CMSISDN:
+			f.CMSISDN = i
+		case ie.ExtendedRANAPCause:
+			f.ExtendedRANAPCause = i
+		case ie.ENodeBID:
+			f.ENodeBID = i
+		case ie.SelectionModeWithNSAPI:
+			f.SelectionModeWithNSAPI = i
+		case ie.UEUsageType:
+			f.UEUsageType = i
+		case ie.ExtendedCommonFlagsII:
+			f.ExtendedCommonFlagsII = i
+		case ie.SCEFPDNConnection:
+			f.SCEFPDNConnection = i
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (f *ForwardRelocationRequest) MarshalLen() int {
+	l := f.Header.MarshalLen() - len(f.Header.Payload)
+
+	if ie := f.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.RANAPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PacketFlowID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.ChargingCharacteristics; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.MMContext; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PDPContext; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.TargetIdentification; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.UTRANTransparentContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PDPContextPrioritization; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.MBMSUEContext; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SelectedPLMNID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.BSSContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.CellIdentification; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.BSSGPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PSHandoverXIDParameters; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.DirectTunnelFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.ReliableInterRATHandoverInfo; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SubscribedRFSPIndex; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.RFSPIndexInUse; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.CoLocatedGGSNPGWFQDN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.EvolvedARPII; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.ExtendedCommonFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.CSGID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.CSGMembershipIndication; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.UENetworkCapability; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.UEAMBR; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.APNAMBRWithNSAPI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SignallingPriorityIndicationWithNSAPI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.HigherBitratesThan16MbpsFlag; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.AdditionalMMContextForSRVCC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.AdditionalFlagsForSRVCC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.STNSR; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.CMSISDN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.ExtendedRANAPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.ENodeBID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SelectionModeWithNSAPI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.UEUsageType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.ExtendedCommonFlagsII; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SCEFPDNConnection; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (f *ForwardRelocationRequest) SetLength() {
+	f.Length = uint16(f.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (f *ForwardRelocationRequest) MessageTypeName() string {
+	return "Forward Relocation Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (f *ForwardRelocationRequest) TEID() uint32 {
+	return f.Header.TEID
+}
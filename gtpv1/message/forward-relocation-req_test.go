@@ -0,0 +1,60 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv1"
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+	"github.com/wmnsk/go-gtp/gtpv1/testutils"
+)
+
+func TestForwardRelocationRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewForwardRelocationRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123450123456789"),
+				ie.NewTEIDCPlane(0xdeadbeef),
+				ie.NewRANAPCause(gtpv1.RANAPCauseRelocationTriggered),
+				ie.New(ie.MMContext, []byte{0x09, 0x41, 0x00, 0x00}),
+				ie.NewGSNAddress("1.1.1.1"),
+				ie.NewTargetIdentification("123", "45", 0x1111, 0x22, 0x0fff),
+				ie.NewUTRANTransparentContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+			),
+			Serialized: []byte{
+				// Header
+				0x32, 0x35, 0x00, 0x34, 0x11, 0x22, 0x33, 0x44,
+				0x00, 0x01, 0x00, 0x00,
+				// IMSI
+				0x02, 0x21, 0x43, 0x05, 0x21, 0x43, 0x65, 0x87, 0xf9,
+				// TEIDCPlane
+				0x11, 0xde, 0xad, 0xbe, 0xef,
+				// RANAPCause
+				0x15, 0x06,
+				// MMContext
+				0x81, 0x00, 0x04, 0x09, 0x41, 0x00, 0x00,
+				// SGSNAddressForControlPlane
+				0x85, 0x00, 0x04, 0x01, 0x01, 0x01, 0x01,
+				// TargetIdentification
+				0x8a, 0x00, 0x08, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x0f, 0xff,
+				// UTRANTransparentContainer
+				0x8b, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseForwardRelocationRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,367 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// ForwardRelocationResponse is a ForwardRelocationResponse Header and its IEs above.
+type ForwardRelocationResponse struct {
+	*Header
+	Cause                         *ie.IE
+	TEIDCPlane                    *ie.IE
+	TEIDDataII                    *ie.IE
+	RANAPCause                    *ie.IE
+	SGSNAddressForControlPlane    *ie.IE
+	SGSNAddressForUserTraffic     *ie.IE
+	UTRANTransparentContainer     *ie.IE
+	RABSetupInformation           *ie.IE
+	AdditionalRABSetupInformation *ie.IE
+	SGSNNumber                    *ie.IE
+	BSSContainer                  *ie.IE
+	BSSGPCause                    *ie.IE
+	ListOfSetupPFCs               *ie.IE
+	ExtendedRANAPCause            *ie.IE
+	NodeIdentifier                *ie.IE
+	PrivateExtension              *ie.IE
+	AdditionalIEs                 []*ie.IE
+}
+
+// NewForwardRelocationResponse creates a new GTPv1 ForwardRelocationResponse.
+func NewForwardRelocationResponse(teid uint32, seq uint16, ies ...*ie.IE) *ForwardRelocationResponse {
+	f := &ForwardRelocationResponse{
+		Header: NewHeader(0x32, MsgTypeForwardRelocationResponse, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			f.Cause = i
+		case ie.TEIDCPlane:
+			f.TEIDCPlane = i
+		case ie.TEIDDataII:
+			f.TEIDDataII = i
+		case ie.RANAPCause:
+			f.RANAPCause = i
+		case ie.GSNAddress:
+			if f.SGSNAddressForControlPlane == nil {
+				f.SGSNAddressForControlPlane = i
+			} else if f.SGSNAddressForUserTraffic == nil {
+				f.SGSNAddressForUserTraffic = i
+			} else {
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.UTRANTransparentContainer:
+			f.UTRANTransparentContainer = i
+		case ie.RABSetupInformation:
+			f.RABSetupInformation = i
+		case ie.AdditionalRABSetupInformation:
+			f.AdditionalRABSetupInformation = i
+		case ie.SGSNNumber:
+			f.SGSNNumber = i
+		case ie.BSSContainer:
+			f.BSSContainer = i
+		case ie.BSSGPCause:
+			f.BSSGPCause = i
+		case ie.ListOfSetupPFCs:
+			f.ListOfSetupPFCs = i
+		case ie.ExtendedRANAPCause:
+			f.ExtendedRANAPCause = i
+		case ie.NodeIdentifier:
+			f.NodeIdentifier = i
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	f.SetLength()
+	return f
+}
+
+// Marshal returns the byte sequence generated from a ForwardRelocationResponse.
+func (f *ForwardRelocationResponse) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (f *ForwardRelocationResponse) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	f.Header.Payload = make([]byte, f.MarshalLen()-f.Header.MarshalLen())
+
+	offset := 0
+	if ie := f.Cause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.TEIDDataII; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.RANAPCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SGSNAddressForUserTraffic; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.UTRANTransparentContainer; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.RABSetupInformation; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.AdditionalRABSetupInformation; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SGSNNumber; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.BSSContainer; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.BSSGPCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.ListOfSetupPFCs; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.ExtendedRANAPCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.NodeIdentifier; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	f.Header.SetLength()
+	return f.Header.MarshalTo(b)
+}
+
+// ParseForwardRelocationResponse decodes a given byte sequence as a ForwardRelocationResponse.
+func ParseForwardRelocationResponse(b []byte) (*ForwardRelocationResponse, error) {
+	f := &ForwardRelocationResponse{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a ForwardRelocationResponse.
+func (f *ForwardRelocationResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	f.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(f.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(f.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			f.Cause = i
+		case ie.TEIDCPlane:
+			f.TEIDCPlane = i
+		case ie.TEIDDataII:
+			f.TEIDDataII = i
+		case ie.RANAPCause:
+			f.RANAPCause = i
+		case ie.GSNAddress:
+			if f.SGSNAddressForControlPlane == nil {
+				f.SGSNAddressForControlPlane = i
+			} else if f.SGSNAddressForUserTraffic == nil {
+				f.SGSNAddressForUserTraffic = i
+			} else {
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.UTRANTransparentContainer:
+			f.UTRANTransparentContainer = i
+		case ie.RABSetupInformation:
+			f.RABSetupInformation = i
+		case ie.AdditionalRABSetupInformation:
+			f.AdditionalRABSetupInformation = i
+		case ie.SGSNNumber:
+			f.SGSNNumber = i
+		case ie.BSSContainer:
+			f.BSSContainer = i
+		case ie.BSSGPCause:
+			f.BSSGPCause = i
+		case ie.ListOfSetupPFCs:
+			f.ListOfSetupPFCs = i
+		case ie.ExtendedRANAPCause:
+			f.ExtendedRANAPCause = i
+		case ie.NodeIdentifier:
+			f.NodeIdentifier = i
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (f *ForwardRelocationResponse) MarshalLen() int {
+	l := f.Header.MarshalLen() - len(f.Header.Payload)
+
+	if ie := f.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.TEIDDataII; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.RANAPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SGSNAddressForUserTraffic; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.UTRANTransparentContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.RABSetupInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.AdditionalRABSetupInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SGSNNumber; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.BSSContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.BSSGPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.ListOfSetupPFCs; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.ExtendedRANAPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.NodeIdentifier; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (f *ForwardRelocationResponse) SetLength() {
+	f.Length = uint16(f.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (f *ForwardRelocationResponse) MessageTypeName() string {
+	return "Forward Relocation Response"
+}
+
+// TEID returns the TEID in human-readable string.
+func (f *ForwardRelocationResponse) TEID() uint32 {
+	return f.Header.TEID
+}
@@ -0,0 +1,57 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv1"
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+	"github.com/wmnsk/go-gtp/gtpv1/testutils"
+)
+
+func TestForwardRelocationResponse(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewForwardRelocationResponse(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewCause(gtpv1.ResCauseRequestAccepted),
+				ie.NewTEIDCPlane(0xdeadbeef),
+				ie.NewGSNAddress("1.1.1.1"),
+				ie.NewGSNAddress("2.2.2.2"),
+				ie.NewUTRANTransparentContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+				ie.NewRABSetupInformation(5, 0xdeadbeef, "3.3.3.3"),
+			),
+			Serialized: []byte{
+				// Header
+				0x32, 0x36, 0x00, 0x2c, 0x11, 0x22, 0x33, 0x44,
+				0x00, 0x01, 0x00, 0x00,
+				// Cause
+				0x01, 0x80,
+				// TEIDCPlane
+				0x11, 0xde, 0xad, 0xbe, 0xef,
+				// SGSNAddressForControlPlane
+				0x85, 0x00, 0x04, 0x01, 0x01, 0x01, 0x01,
+				// SGSNAddressForUserTraffic
+				0x85, 0x00, 0x04, 0x02, 0x02, 0x02, 0x02,
+				// UTRANTransparentContainer
+				0x8b, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef,
+				// RABSetupInformation
+				0x8c, 0x00, 0x09, 0x05, 0xde, 0xad, 0xbe, 0xef, 0x03, 0x03, 0x03, 0x03,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseForwardRelocationResponse(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+	"github.com/wmnsk/go-gtp/gtpv1/testutils"
+)
+
+func TestForwardSRNSContext(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewForwardSRNSContext(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.New(ie.RABContext, []byte{0x05, 0x00, 0x01, 0x00, 0x02, 0x03, 0x04, 0x05, 0x06}),
+				ie.New(ie.PDUNumbers, []byte{0x05, 0x00, 0x01, 0x00, 0x02, 0x00, 0x03, 0x00, 0x04}),
+			),
+			Serialized: []byte{
+				// Header
+				0x32, 0x3a, 0x00, 0x1a, 0x11, 0x22, 0x33, 0x44,
+				0x00, 0x01, 0x00, 0x00,
+				// RABContext
+				0x16, 0x05, 0x00, 0x01, 0x00, 0x02, 0x03, 0x04, 0x05, 0x06,
+				// PDUNumbers
+				0xaf, 0x00, 0x09, 0x05, 0x00, 0x01, 0x00, 0x02, 0x00, 0x03, 0x00, 0x04,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseForwardSRNSContext(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
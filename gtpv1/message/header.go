@@ -23,7 +23,13 @@ type Header struct {
 	TEID           uint32
 	SequenceNumber uint16
 	Reserved       uint16
-	Payload        []byte
+	// NPDUNumber is the N-PDU Number, which is present only when the PN flag
+	// is set in Flags.
+	NPDUNumber uint8
+	// ExtensionHeaders is the chain of Extension Headers, which is present
+	// only when the E flag is set in Flags.
+	ExtensionHeaders []*ExtensionHeader
+	Payload          []byte
 }
 
 // NewHeader creates a new Header.
@@ -62,19 +68,46 @@ func (h *Header) MarshalTo(b []byte) error {
 	if len(b) < h.MarshalLen() {
 		return ErrTooShortToMarshal
 	}
+	if h.HasExtensionHeader() {
+		for _, e := range h.ExtensionHeaders {
+			if err := e.validate(); err != nil {
+				return err
+			}
+		}
+	}
 
 	b[0] = h.Flags
 	b[1] = h.Type
 	binary.BigEndian.PutUint16(b[2:4], h.Length)
 	binary.BigEndian.PutUint32(b[4:8], h.TEID)
 	offset := 8
-	if h.HasSequence() {
+	if h.hasOptionalFields() {
 		binary.BigEndian.PutUint16(b[offset:offset+2], h.SequenceNumber)
-		// two bytes of padding before payload.
+		b[offset+2] = 0
+		if h.HasNPDUNumber() {
+			b[offset+2] = h.NPDUNumber
+		}
+		b[offset+3] = 0
+		if h.HasExtensionHeader() && len(h.ExtensionHeaders) > 0 {
+			b[offset+3] = h.ExtensionHeaders[0].Type
+		}
 		offset += 4
 	}
 
-	// two bytes of padding before payload.
+	if h.HasExtensionHeader() {
+		for i, e := range h.ExtensionHeaders {
+			l := e.MarshalLen()
+			b[offset] = uint8(l / 4)
+			copy(b[offset+1:offset+l-1], e.Content)
+			if i+1 < len(h.ExtensionHeaders) {
+				b[offset+l-1] = h.ExtensionHeaders[i+1].Type
+			} else {
+				b[offset+l-1] = ExtHeaderTypeNoMoreExtensionHeaders
+			}
+			offset += l
+		}
+	}
+
 	copy(b[offset:], h.Payload)
 	return nil
 }
@@ -91,7 +124,7 @@ func ParseHeader(b []byte) (*Header, error) {
 // UnmarshalBinary sets the values retrieved from byte sequence in GTPv1 header.
 func (h *Header) UnmarshalBinary(b []byte) error {
 	l := len(b)
-	if l < 11 {
+	if l < fixedHeaderSize {
 		return ErrTooShortToParse
 	}
 	var offset = 4
@@ -101,13 +134,41 @@ func (h *Header) UnmarshalBinary(b []byte) error {
 
 	h.TEID = binary.BigEndian.Uint32(b[4:8])
 	offset += 4
-	if h.HasSequence() {
-		if h.Length < seqSize {
+	if h.hasOptionalFields() {
+		if h.Length < seqSize || l < fixedHeaderSize+seqSize {
 			return ErrTooShortToParse
 		}
 		h.SequenceNumber = binary.BigEndian.Uint16(b[offset : offset+2])
-		// two bytes of padding before payload.
+		if h.HasNPDUNumber() {
+			h.NPDUNumber = b[offset+2]
+		}
+		next := b[offset+3]
 		offset += 4
+
+		if h.HasExtensionHeader() {
+			h.ExtensionHeaders = nil
+			for next != ExtHeaderTypeNoMoreExtensionHeaders {
+				if offset >= l {
+					return ErrTooShortToParse
+				}
+				el := int(b[offset]) * 4
+				if el == 0 {
+					return ErrInvalidLength
+				}
+				if offset+el > l {
+					return ErrTooShortToParse
+				}
+
+				content := make([]byte, el-2)
+				copy(content, b[offset+1:offset+el-1])
+				h.ExtensionHeaders = append(h.ExtensionHeaders, &ExtensionHeader{
+					Type:    next,
+					Content: content,
+				})
+				next = b[offset+el-1]
+				offset += el
+			}
+		}
 	}
 
 	if int(h.Length)+fixedHeaderSize > l {
@@ -134,6 +195,39 @@ func (h *Header) HasSequence() bool {
 	return ((int(h.Flags) >> 1) & 0x1) == 1
 }
 
+// HasExtensionHeader determines whether a GTP Header has Extension Headers by checking the flag.
+func (h *Header) HasExtensionHeader() bool {
+	return ((int(h.Flags) >> 2) & 0x1) == 1
+}
+
+// HasNPDUNumber determines whether a GTP Header has N-PDU Number by checking the flag.
+func (h *Header) HasNPDUNumber() bool {
+	return (int(h.Flags) & 0x1) == 1
+}
+
+// hasOptionalFields reports whether Sequence Number, N-PDU Number and Next Extension
+// Header Type fields are present, which is the case if any of E, S or PN flag is set.
+func (h *Header) hasOptionalFields() bool {
+	return h.HasExtensionHeader() || h.HasSequence() || h.HasNPDUNumber()
+}
+
+// SetNPDUNumber sets the PN flag to 1 and puts the N-PDU Number given into NPDUNumber field.
+func (h *Header) SetNPDUNumber(n uint8) {
+	h.Flags |= 1
+	h.NPDUNumber = n
+	h.SetLength()
+}
+
+// AddExtensionHeaders sets the E flag to 1 and appends the Extension Headers given.
+func (h *Header) AddExtensionHeaders(ehs ...*ExtensionHeader) {
+	if len(ehs) == 0 {
+		return
+	}
+	h.Flags |= (1 << 2)
+	h.ExtensionHeaders = append(h.ExtensionHeaders, ehs...)
+	h.SetLength()
+}
+
 // Sequence returns SequenceNumber in uint16.
 func (h *Header) Sequence() uint16 {
 	return h.SequenceNumber
@@ -147,9 +241,14 @@ func (h *Header) SetSequenceNumber(seq uint16) {
 // MarshalLen returns the serial length of Header.
 func (h *Header) MarshalLen() int {
 	l := len(h.Payload) + 8
-	if h.HasSequence() {
+	if h.hasOptionalFields() {
 		l += 4
 	}
+	if h.HasExtensionHeader() {
+		for _, e := range h.ExtensionHeaders {
+			l += e.MarshalLen()
+		}
+	}
 
 	return l
 }
@@ -171,12 +270,14 @@ func (h *Header) MessageType() uint8 {
 
 // String returns the GTPv1 header values in human readable format.
 func (h *Header) String() string {
-	return fmt.Sprintf("{Flags: %#x, Type: %#x, Length: %d, TEID: %#08x, SequenceNumber: %#04x, Payload: %#v}",
+	return fmt.Sprintf("{Flags: %#x, Type: %#x, Length: %d, TEID: %#08x, SequenceNumber: %#04x, NPDUNumber: %#02x, ExtensionHeaders: %v, Payload: %#v}",
 		h.Flags,
 		h.Type,
 		h.Length,
 		h.TEID,
 		h.SequenceNumber,
+		h.NPDUNumber,
+		h.ExtensionHeaders,
 		h.Payload,
 	)
 }
@@ -34,6 +34,58 @@ func TestHeader(t *testing.T) {
 				0x32, 0x10, 0x00, 0x08, 0xde, 0xad, 0xbe, 0xef,
 				0xca, 0xfe, 0x00, 0x00, 0xde, 0xad, 0xbe, 0xef,
 			},
+		}, {
+			Description: "With-ExtensionHeaders",
+			Structured: func() *message.Header {
+				h := message.NewHeader(
+					message.NewHeaderFlags(1, 1, 0, 1, 0), // Flags
+					0xff,                                  // Message type
+					0xdeadbeef,                            // TEID
+					0xcafe,                                // Sequence Number
+					[]byte{0xde, 0xad, 0xbe, 0xef},        // Payload
+				)
+				h.AddExtensionHeaders(
+					message.NewPDCPPDUNumberExtensionHeader(0x1234),
+					message.NewLongPDCPPDUNumberExtensionHeader(0x3ffff),
+					message.NewRANContainerExtensionHeader([]byte{0x01, 0x02, 0x03}),
+				)
+				return h
+			}(),
+			Serialized: []byte{
+				// Flags, Type, Length, TEID
+				0x36, 0xff, 0x00, 0x1c, 0xde, 0xad, 0xbe, 0xef,
+				// Sequence Number, N-PDU Number, Next Extension Header Type
+				0xca, 0xfe, 0x00, 0xc0,
+				// PDCP PDU Number
+				0x01, 0x12, 0x34, 0x82,
+				// Long PDCP PDU Number
+				0x02, 0x03, 0xff, 0xff, 0x00, 0x00, 0x00, 0x81,
+				// RAN Container
+				0x02, 0x01, 0x02, 0x03, 0x00, 0x00, 0x00, 0x00,
+				// Payload
+				0xde, 0xad, 0xbe, 0xef,
+			},
+		}, {
+			Description: "With-NPDUNumber",
+			Structured: func() *message.Header {
+				h := message.NewHeader(
+					message.NewHeaderFlags(1, 1, 0, 1, 0), // Flags
+					0xff,                                  // Message type
+					0xdeadbeef,                            // TEID
+					0xcafe,                                // Sequence Number
+					[]byte{0xde, 0xad, 0xbe, 0xef},        // Payload
+				)
+				h.SetNPDUNumber(0x5a)
+				return h
+			}(),
+			Serialized: []byte{
+				// Flags, Type, Length, TEID
+				0x33, 0xff, 0x00, 0x08, 0xde, 0xad, 0xbe, 0xef,
+				// Sequence Number, N-PDU Number, Next Extension Header Type
+				0xca, 0xfe, 0x5a, 0x00,
+				// Payload
+				0xde, 0xad, 0xbe, 0xef,
+			},
 		},
 	}
 
@@ -0,0 +1,219 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// IdentificationRequest is a IdentificationRequest Header and its IEs above.
+type IdentificationRequest struct {
+	*Header
+	RAI                        *ie.IE
+	PacketTMSI                 *ie.IE
+	PTMSISignature             *ie.IE
+	SGSNAddressForControlPlane *ie.IE
+	HopCounter                 *ie.IE
+	PrivateExtension           *ie.IE
+	AdditionalIEs              []*ie.IE
+}
+
+// NewIdentificationRequest creates a new GTPv1 IdentificationRequest.
+func NewIdentificationRequest(teid uint32, seq uint16, ies ...*ie.IE) *IdentificationRequest {
+	m := &IdentificationRequest{
+		Header: NewHeader(0x32, MsgTypeIdentificationRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.RouteingAreaIdentity:
+			m.RAI = i
+		case ie.PacketTMSI:
+			m.PacketTMSI = i
+		case ie.PTMSISignature:
+			m.PTMSISignature = i
+		case ie.GSNAddress:
+			m.SGSNAddressForControlPlane = i
+		case ie.HopCounter:
+			m.HopCounter = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal returns the byte sequence generated from a IdentificationRequest.
+func (m *IdentificationRequest) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (m *IdentificationRequest) MarshalTo(b []byte) error {
+	if len(b) < m.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
+
+	offset := 0
+	if ie := m.RAI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PacketTMSI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PTMSISignature; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.SGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.HopCounter; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	m.Header.SetLength()
+	return m.Header.MarshalTo(b)
+}
+
+// ParseIdentificationRequest decodes a given byte sequence as a IdentificationRequest.
+func ParseIdentificationRequest(b []byte) (*IdentificationRequest, error) {
+	m := &IdentificationRequest{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a IdentificationRequest.
+func (m *IdentificationRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.RouteingAreaIdentity:
+			m.RAI = i
+		case ie.PacketTMSI:
+			m.PacketTMSI = i
+		case ie.PTMSISignature:
+			m.PTMSISignature = i
+		case ie.GSNAddress:
+			m.SGSNAddressForControlPlane = i
+		case ie.HopCounter:
+			m.HopCounter = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (m *IdentificationRequest) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if ie := m.RAI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PacketTMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PTMSISignature; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.SGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.HopCounter; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *IdentificationRequest) SetLength() {
+	m.Length = uint16(m.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *IdentificationRequest) MessageTypeName() string {
+	return "Identification Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (m *IdentificationRequest) TEID() uint32 {
+	return m.Header.TEID
+}
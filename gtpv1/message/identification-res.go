@@ -0,0 +1,233 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// IdentificationResponse is a IdentificationResponse Header and its IEs above.
+type IdentificationResponse struct {
+	*Header
+	Cause                    *ie.IE
+	IMSI                     *ie.IE
+	AuthenticationTriplet    *ie.IE
+	AuthenticationQuintuplet *ie.IE
+	UEUsageType              *ie.IE
+	IOVUpdatesCounter        *ie.IE
+	PrivateExtension         *ie.IE
+	AdditionalIEs            []*ie.IE
+}
+
+// NewIdentificationResponse creates a new GTPv1 IdentificationResponse.
+func NewIdentificationResponse(teid uint32, seq uint16, ies ...*ie.IE) *IdentificationResponse {
+	m := &IdentificationResponse{
+		Header: NewHeader(0x32, MsgTypeIdentificationResponse, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			m.Cause = i
+		case ie.IMSI:
+			m.IMSI = i
+		case ie.AuthenticationTriplet:
+			m.AuthenticationTriplet = i
+		case ie.AuthenticationQuintuplet:
+			m.AuthenticationQuintuplet = i
+		case ie.UEUsageType:
+			m.UEUsageType = i
+		case ie.IOVUpdatesCounter:
+			m.IOVUpdatesCounter = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal returns the byte sequence generated from a IdentificationResponse.
+func (m *IdentificationResponse) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (m *IdentificationResponse) MarshalTo(b []byte) error {
+	if len(b) < m.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
+
+	offset := 0
+	if ie := m.Cause; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.IMSI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.AuthenticationTriplet; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.AuthenticationQuintuplet; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.UEUsageType; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.IOVUpdatesCounter; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	m.Header.SetLength()
+	return m.Header.MarshalTo(b)
+}
+
+// ParseIdentificationResponse decodes a given byte sequence as a IdentificationResponse.
+func ParseIdentificationResponse(b []byte) (*IdentificationResponse, error) {
+	m := &IdentificationResponse{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a IdentificationResponse.
+func (m *IdentificationResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			m.Cause = i
+		case ie.IMSI:
+			m.IMSI = i
+		case ie.AuthenticationTriplet:
+			m.AuthenticationTriplet = i
+		case ie.AuthenticationQuintuplet:
+			m.AuthenticationQuintuplet = i
+		case ie.UEUsageType:
+			m.UEUsageType = i
+		case ie.IOVUpdatesCounter:
+			m.IOVUpdatesCounter = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (m *IdentificationResponse) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if ie := m.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.AuthenticationTriplet; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.AuthenticationQuintuplet; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.UEUsageType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.IOVUpdatesCounter; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *IdentificationResponse) SetLength() {
+	m.Length = uint16(m.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *IdentificationResponse) MessageTypeName() string {
+	return "Identification Response"
+}
+
+// TEID returns the TEID in human-readable string.
+func (m *IdentificationResponse) TEID() uint32 {
+	return m.Header.TEID
+}
@@ -0,0 +1,213 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// MBMSRegistrationRequest is a MBMSRegistrationRequest Header and its IEs above.
+type MBMSRegistrationRequest struct {
+	*Header
+	EndUserAddress                        *ie.IE
+	APN                                   *ie.IE
+	SGSNAddressForControlPlane            *ie.IE
+	AlternativeSGSNAddressForControlPlane *ie.IE
+	PrivateExtension                      *ie.IE
+	AdditionalIEs                         []*ie.IE
+}
+
+// NewMBMSRegistrationRequest creates a new GTPv1 MBMSRegistrationRequest.
+func NewMBMSRegistrationRequest(teid uint32, seq uint16, ies ...*ie.IE) *MBMSRegistrationRequest {
+	m := &MBMSRegistrationRequest{
+		Header: NewHeader(0x32, MsgTypeMBMSRegistrationRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.EndUserAddress:
+			m.EndUserAddress = i
+		case ie.AccessPointName:
+			m.APN = i
+		case ie.GSNAddress:
+			if m.SGSNAddressForControlPlane == nil {
+				m.SGSNAddressForControlPlane = i
+			} else if m.AlternativeSGSNAddressForControlPlane == nil {
+				m.AlternativeSGSNAddressForControlPlane = i
+			} else {
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal returns the byte sequence generated from a MBMSRegistrationRequest.
+func (m *MBMSRegistrationRequest) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (m *MBMSRegistrationRequest) MarshalTo(b []byte) error {
+	if len(b) < m.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
+
+	offset := 0
+	if ie := m.EndUserAddress; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.APN; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.SGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.AlternativeSGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	m.Header.SetLength()
+	return m.Header.MarshalTo(b)
+}
+
+// ParseMBMSRegistrationRequest decodes a given byte sequence as a MBMSRegistrationRequest.
+func ParseMBMSRegistrationRequest(b []byte) (*MBMSRegistrationRequest, error) {
+	m := &MBMSRegistrationRequest{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a MBMSRegistrationRequest.
+func (m *MBMSRegistrationRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.EndUserAddress:
+			m.EndUserAddress = i
+		case ie.AccessPointName:
+			m.APN = i
+		case ie.GSNAddress:
+			if m.SGSNAddressForControlPlane == nil {
+				m.SGSNAddressForControlPlane = i
+			} else if m.AlternativeSGSNAddressForControlPlane == nil {
+				m.AlternativeSGSNAddressForControlPlane = i
+			} else {
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (m *MBMSRegistrationRequest) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if ie := m.EndUserAddress; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.APN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.SGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.AlternativeSGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *MBMSRegistrationRequest) SetLength() {
+	m.Length = uint16(m.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *MBMSRegistrationRequest) MessageTypeName() string {
+	return "MBMS Registration Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (m *MBMSRegistrationRequest) TEID() uint32 {
+	return m.Header.TEID
+}
@@ -0,0 +1,395 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// MBMSSessionStartRequest is a MBMSSessionStartRequest Header and its IEs above.
+type MBMSSessionStartRequest struct {
+	*Header
+	Recovery                              *ie.IE
+	TEIDCPlane                            *ie.IE
+	EndUserAddress                        *ie.IE
+	APN                                   *ie.IE
+	GGSNAddressForControlPlane            *ie.IE
+	AlternativeGGSNAddressForControlPlane *ie.IE
+	QoSProfile                            *ie.IE
+	CommonFlags                           *ie.IE
+	TMGI                                  *ie.IE
+	MBMSServiceArea                       *ie.IE
+	MBMSSessionIdentifier                 *ie.IE
+	MBMS2G3GIndicator                     *ie.IE
+	MBMSSessionDuration                   *ie.IE
+	MBMSSessionRepetitionNumber           *ie.IE
+	MBMSTimeToDataTransfer                *ie.IE
+	MBMSFlowIdentifier                    *ie.IE
+	MBMSIPMulticastDistribution           *ie.IE
+	PrivateExtension                      *ie.IE
+	AdditionalIEs                         []*ie.IE
+}
+
+// NewMBMSSessionStartRequest creates a new GTPv1 MBMSSessionStartRequest.
+func NewMBMSSessionStartRequest(teid uint32, seq uint16, ies ...*ie.IE) *MBMSSessionStartRequest {
+	m := &MBMSSessionStartRequest{
+		Header: NewHeader(0x32, MsgTypeMBMSSessionStartRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Recovery:
+			m.Recovery = i
+		case ie.TEIDCPlane:
+			m.TEIDCPlane = i
+		case ie.EndUserAddress:
+			m.EndUserAddress = i
+		case ie.AccessPointName:
+			m.APN = i
+		case ie.GSNAddress:
+			if m.GGSNAddressForControlPlane == nil {
+				m.GGSNAddressForControlPlane = i
+			} else if m.AlternativeGGSNAddressForControlPlane == nil {
+				m.AlternativeGGSNAddressForControlPlane = i
+			} else {
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.QoSProfile:
+			m.QoSProfile = i
+		case ie.CommonFlags:
+			m.CommonFlags = i
+		case ie.TemporaryMobileGroupIdentity:
+			m.TMGI = i
+		case ie.MBMSServiceArea:
+			m.MBMSServiceArea = i
+		case ie.MBMSSessionIdentifier:
+			m.MBMSSessionIdentifier = i
+		case ie.MBMS2G3GIndicator:
+			m.MBMS2G3GIndicator = i
+		case ie.MBMSSessionDuration:
+			m.MBMSSessionDuration = i
+		case ie.MBMSSessionRepetitionNumber:
+			m.MBMSSessionRepetitionNumber = i
+		case ie.MBMSTimeToDataTransfer:
+			m.MBMSTimeToDataTransfer = i
+		case ie.MBMSFlowIdentifier:
+			m.MBMSFlowIdentifier = i
+		case ie.MBMSIPMulticastDistribution:
+			m.MBMSIPMulticastDistribution = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal returns the byte sequence generated from a MBMSSessionStartRequest.
+func (m *MBMSSessionStartRequest) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (m *MBMSSessionStartRequest) MarshalTo(b []byte) error {
+	if len(b) < m.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
+
+	offset := 0
+	if ie := m.Recovery; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.EndUserAddress; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.APN; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.GGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.AlternativeGGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.QoSProfile; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.CommonFlags; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.TMGI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MBMSServiceArea; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MBMSSessionIdentifier; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MBMS2G3GIndicator; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MBMSSessionDuration; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MBMSSessionRepetitionNumber; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MBMSTimeToDataTransfer; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MBMSFlowIdentifier; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MBMSIPMulticastDistribution; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	m.Header.SetLength()
+	return m.Header.MarshalTo(b)
+}
+
+// ParseMBMSSessionStartRequest decodes a given byte sequence as a MBMSSessionStartRequest.
+func ParseMBMSSessionStartRequest(b []byte) (*MBMSSessionStartRequest, error) {
+	m := &MBMSSessionStartRequest{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a MBMSSessionStartRequest.
+func (m *MBMSSessionStartRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Recovery:
+			m.Recovery = i
+		case ie.TEIDCPlane:
+			m.TEIDCPlane = i
+		case ie.EndUserAddress:
+			m.EndUserAddress = i
+		case ie.AccessPointName:
+			m.APN = i
+		case ie.GSNAddress:
+			if m.GGSNAddressForControlPlane == nil {
+				m.GGSNAddressForControlPlane = i
+			} else if m.AlternativeGGSNAddressForControlPlane == nil {
+				m.AlternativeGGSNAddressForControlPlane = i
+			} else {
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.QoSProfile:
+			m.QoSProfile = i
+		case ie.CommonFlags:
+			m.CommonFlags = i
+		case ie.TemporaryMobileGroupIdentity:
+			m.TMGI = i
+		case ie.MBMSServiceArea:
+			m.MBMSServiceArea = i
+		case ie.MBMSSessionIdentifier:
+			m.MBMSSessionIdentifier = i
+		case ie.MBMS2G3GIndicator:
+			m.MBMS2G3GIndicator = i
+		case ie.MBMSSessionDuration:
+			m.MBMSSessionDuration = i
+		case ie.MBMSSessionRepetitionNumber:
+			m.MBMSSessionRepetitionNumber = i
+		case ie.MBMSTimeToDataTransfer:
+			m.MBMSTimeToDataTransfer = i
+		case ie.MBMSFlowIdentifier:
+			m.MBMSFlowIdentifier = i
+		case ie.MBMSIPMulticastDistribution:
+			m.MBMSIPMulticastDistribution = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (m *MBMSSessionStartRequest) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if ie := m.Recovery; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.EndUserAddress; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.APN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.GGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.AlternativeGGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.QoSProfile; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.CommonFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.TMGI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MBMSServiceArea; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MBMSSessionIdentifier; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MBMS2G3GIndicator; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MBMSSessionDuration; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MBMSSessionRepetitionNumber; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MBMSTimeToDataTransfer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MBMSFlowIdentifier; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MBMSIPMulticastDistribution; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *MBMSSessionStartRequest) SetLength() {
+	m.Length = uint16(m.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *MBMSSessionStartRequest) MessageTypeName() string {
+	return "MBMS Session Start Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (m *MBMSSessionStartRequest) TEID() uint32 {
+	return m.Header.TEID
+}
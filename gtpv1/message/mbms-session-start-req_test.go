@@ -0,0 +1,80 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+	"github.com/wmnsk/go-gtp/gtpv1/testutils"
+)
+
+func TestMBMSSessionStartRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewMBMSSessionStartRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewRecovery(0),
+				ie.NewTEIDCPlane(0xdeadbeef),
+				ie.NewEndUserAddress("239.0.0.1"),
+				ie.NewAccessPointName("some.apn.example"),
+				ie.NewGSNAddress("1.1.1.1"),
+				ie.NewQoSProfile([]byte{0x00, 0x1b, 0x93, 0x1f, 0x73, 0x96, 0x97, 0x97, 0x44, 0xfb, 0x10, 0x10}),
+				ie.NewTemporaryMobileGroupIdentity(0x123456, "123", "45"),
+				ie.NewMBMSServiceArea(1, 2),
+				ie.NewMBMSSessionIdentifier(1),
+				ie.NewMBMSSessionDuration(time.Hour),
+				ie.NewMBMSSessionRepetitionNumber(3),
+				ie.NewMBMSFlowIdentifier(0x1234),
+				ie.NewMBMSIPMulticastDistribution(0xdeadbeef, "239.0.0.1", "1.1.1.1", 0),
+			),
+			Serialized: []byte{
+				// Header
+				0x32, 0x74, 0x00, 0x74, 0x11, 0x22, 0x33, 0x44,
+				0x00, 0x01, 0x00, 0x00,
+				// Recovery
+				0x0e, 0x00,
+				// TEIDCPlane
+				0x11, 0xde, 0xad, 0xbe, 0xef,
+				// EndUserAddress
+				0x80, 0x00, 0x06, 0xf1, 0x21, 0xef, 0x00, 0x00, 0x01,
+				// APN
+				0x83, 0x00, 0x11, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e, 0x07, 0x65, 0x78, 0x61,
+				0x6d, 0x70, 0x6c, 0x65,
+				// GGSNAddressForControlPlane
+				0x85, 0x00, 0x04, 0x01, 0x01, 0x01, 0x01,
+				// QoSProfile
+				0x87, 0x00, 0x0c, 0x00, 0x1b, 0x93, 0x1f, 0x73, 0x96, 0x97, 0x97, 0x44, 0xfb, 0x10, 0x10,
+				// TMGI
+				0x9d, 0x00, 0x06, 0x12, 0x34, 0x56, 0x21, 0xf3, 0x54,
+				// MBMSServiceArea
+				0xa0, 0x00, 0x05, 0x01, 0x00, 0x01, 0x00, 0x02,
+				// MBMSSessionIdentifier
+				0xa5, 0x00, 0x01, 0x01,
+				// MBMSSessionDuration
+				0xa8, 0x00, 0x03, 0x07, 0x08, 0x00,
+				// MBMSSessionRepetitionNumber
+				0xaa, 0x00, 0x01, 0x03,
+				// MBMSFlowIdentifier
+				0xb9, 0x00, 0x02, 0x12, 0x34,
+				// MBMSIPMulticastDistribution
+				0xba, 0x00, 0x0f, 0xde, 0xad, 0xbe, 0xef, 0x04, 0xef, 0x00, 0x00, 0x01, 0x04, 0x01, 0x01, 0x01,
+				0x01, 0x00,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseMBMSSessionStartRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
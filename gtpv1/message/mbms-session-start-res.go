@@ -0,0 +1,269 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// MBMSSessionStartResponse is a MBMSSessionStartResponse Header and its IEs above.
+type MBMSSessionStartResponse struct {
+	*Header
+	Cause                                *ie.IE
+	Recovery                             *ie.IE
+	TEIDDataI                            *ie.IE
+	TEIDCPlane                           *ie.IE
+	SGSNAddressForControlPlane           *ie.IE
+	SGSNAddressForUserTraffic            *ie.IE
+	AlternativeSGSNAddressForUserTraffic *ie.IE
+	MBMSDistributionAcknowledgement      *ie.IE
+	PrivateExtension                     *ie.IE
+	AdditionalIEs                        []*ie.IE
+}
+
+// NewMBMSSessionStartResponse creates a new GTPv1 MBMSSessionStartResponse.
+func NewMBMSSessionStartResponse(teid uint32, seq uint16, ies ...*ie.IE) *MBMSSessionStartResponse {
+	m := &MBMSSessionStartResponse{
+		Header: NewHeader(0x32, MsgTypeMBMSSessionStartResponse, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			m.Cause = i
+		case ie.Recovery:
+			m.Recovery = i
+		case ie.TEIDDataI:
+			m.TEIDDataI = i
+		case ie.TEIDCPlane:
+			m.TEIDCPlane = i
+		case ie.GSNAddress:
+			if m.SGSNAddressForControlPlane == nil {
+				m.SGSNAddressForControlPlane = i
+			} else if m.SGSNAddressForUserTraffic == nil {
+				m.SGSNAddressForUserTraffic = i
+			} else if m.AlternativeSGSNAddressForUserTraffic == nil {
+				m.AlternativeSGSNAddressForUserTraffic = i
+			} else {
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.MBMSDistributionAcknowledgement:
+			m.MBMSDistributionAcknowledgement = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal returns the byte sequence generated from a MBMSSessionStartResponse.
+func (m *MBMSSessionStartResponse) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (m *MBMSSessionStartResponse) MarshalTo(b []byte) error {
+	if len(b) < m.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
+
+	offset := 0
+	if ie := m.Cause; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.Recovery; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.TEIDDataI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.SGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.SGSNAddressForUserTraffic; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.AlternativeSGSNAddressForUserTraffic; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MBMSDistributionAcknowledgement; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	m.Header.SetLength()
+	return m.Header.MarshalTo(b)
+}
+
+// ParseMBMSSessionStartResponse decodes a given byte sequence as a MBMSSessionStartResponse.
+func ParseMBMSSessionStartResponse(b []byte) (*MBMSSessionStartResponse, error) {
+	m := &MBMSSessionStartResponse{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a MBMSSessionStartResponse.
+func (m *MBMSSessionStartResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			m.Cause = i
+		case ie.Recovery:
+			m.Recovery = i
+		case ie.TEIDDataI:
+			m.TEIDDataI = i
+		case ie.TEIDCPlane:
+			m.TEIDCPlane = i
+		case ie.GSNAddress:
+			if m.SGSNAddressForControlPlane == nil {
+				m.SGSNAddressForControlPlane = i
+			} else if m.SGSNAddressForUserTraffic == nil {
+				m.SGSNAddressForUserTraffic = i
+			} else if m.AlternativeSGSNAddressForUserTraffic == nil {
+				m.AlternativeSGSNAddressForUserTraffic = i
+			} else {
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.MBMSDistributionAcknowledgement:
+			m.MBMSDistributionAcknowledgement = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (m *MBMSSessionStartResponse) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if ie := m.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.Recovery; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.TEIDDataI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.SGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.SGSNAddressForUserTraffic; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.AlternativeSGSNAddressForUserTraffic; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MBMSDistributionAcknowledgement; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *MBMSSessionStartResponse) SetLength() {
+	m.Length = uint16(m.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *MBMSSessionStartResponse) MessageTypeName() string {
+	return "MBMS Session Start Response"
+}
+
+// TEID returns the TEID in human-readable string.
+func (m *MBMSSessionStartResponse) TEID() uint32 {
+	return m.Header.TEID
+}
@@ -48,7 +48,7 @@ const (
 	MsgTypePDUNotificationResponse
 	MsgTypePDUNotificationRejectRequest
 	MsgTypePDUNotificationRejectResponse
-	_
+	MsgTypeSupportedExtensionHeadersNotification
 	MsgTypeSendRoutingInfoRequest
 	MsgTypeSendRoutingInfoResponse
 	MsgTypeFailureReportRequest
@@ -65,6 +65,19 @@ const (
 	_
 	_
 	_
+	MsgTypeIdentificationRequest // 48
+	MsgTypeIdentificationResponse
+	MsgTypeSGSNContextRequest
+	MsgTypeSGSNContextResponse
+	MsgTypeSGSNContextAcknowledge
+	MsgTypeForwardRelocationRequest
+	MsgTypeForwardRelocationResponse
+	MsgTypeForwardRelocationComplete
+	MsgTypeRelocationCancelRequest
+	MsgTypeRelocationCancelResponse
+	MsgTypeForwardSRNSContext
+	MsgTypeForwardRelocationCompleteAcknowledge
+	MsgTypeForwardSRNSContextAcknowledge
 	_
 	_
 	_
@@ -73,13 +86,62 @@ const (
 	_
 	_
 	_
-	MsgTypeIdentificationRequest // 48
-	MsgTypeIdentificationResponse
-	MsgTypeSGSNContextRequest
-	MsgTypeSGSNContextResponse
-	MsgTypeSGSNContextAcknowledge
+	_
+	MsgTypeRANInformationRelay // 70
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	_
+	MsgTypeMBMSNotificationRequest // 96
+	MsgTypeMBMSNotificationResponse
+	MsgTypeMBMSNotificationRejectRequest
+	MsgTypeMBMSNotificationRejectResponse
+	MsgTypeCreateMBMSContextRequest
+	MsgTypeCreateMBMSContextResponse
+	MsgTypeUpdateMBMSContextRequest
+	MsgTypeUpdateMBMSContextResponse
+	MsgTypeDeleteMBMSContextRequest
+	MsgTypeDeleteMBMSContextResponse
+	_
+	_
+	_
+	_
+	_
+	_
+	MsgTypeMBMSRegistrationRequest // 112
+	MsgTypeMBMSRegistrationResponse
+	MsgTypeMBMSDeRegistrationRequest
+	MsgTypeMBMSDeRegistrationResponse
+	MsgTypeMBMSSessionStartRequest
+	MsgTypeMBMSSessionStartResponse
+	MsgTypeMBMSSessionStopRequest
+	MsgTypeMBMSSessionStopResponse
+	MsgTypeMBMSSessionUpdateRequest
+	MsgTypeMBMSSessionUpdateResponse
 	MsgTypeDataRecordTransferRequest  uint8 = 240
 	MsgTypeDataRecordTransferResponse uint8 = 241
+	MsgTypeEndMarker                  uint8 = 254
 	MsgTypeTPDU                       uint8 = 255
 )
 
@@ -135,62 +197,120 @@ func Parse(b []byte) (Message, error) {
 		m = &VersionNotSupported{}
 	case MsgTypeDeletePDPContextResponse:
 		m = &DeletePDPContextResponse{}
-	/* XXX - Implement!
 	case MsgTypeNodeAliveRequest:
-		m = &NodeAliveReq{}
+		m = &NodeAliveRequest{}
 	case MsgTypeNodeAliveResponse:
-		m = &NodeAliveRes{}
+		m = &NodeAliveResponse{}
 	case MsgTypeRedirectionRequest:
-		m = &RedirectionReq{}
+		m = &RedirectionRequest{}
 	case MsgTypeRedirectionResponse:
-		m = &RedirectionRes{}
-	case MsgTypeCreateAaPDPContextRequest:
-		m = &CreateAaPDPContextReq{}
-	case MsgTypeCreateAaPDPContextResponse:
-		m = &CreateAaPDPContextRes{}
-	case MsgTypeDeleteAaPDPContextRequest:
-		m = &DeleteAaPDPContextReq{}
-	case MsgTypeDeleteAaPDPContextResponse:
-		m = &DeleteAaPDPContextRes{}
-	*/
+		m = &RedirectionResponse{}
+	case MsgTypeCreateAAPDPContextRequest:
+		m = &CreateAAPDPContextRequest{}
+	case MsgTypeCreateAAPDPContextResponse:
+		m = &CreateAAPDPContextResponse{}
+	case MsgTypeDeleteAAPDPContextRequest:
+		m = &DeleteAAPDPContextRequest{}
+	case MsgTypeDeleteAAPDPContextResponse:
+		m = &DeleteAAPDPContextResponse{}
 	case MsgTypeErrorIndication:
 		m = &ErrorIndication{}
-	/* XXX - Implement!
-	case MsgTypePduNotificationRequest:
-		m = &PduNotificationReq{}
-	case MsgTypePduNotificationResponse:
-		m = &PduNotificationRes{}
-	case MsgTypePduNotificationRejectRequest:
-		m = &PduNotificationRejectReq{}
-	case MsgTypePduNotificationRejectResponse:
-		m = &PduNotificationRejectRes{}
+	case MsgTypePDUNotificationRequest:
+		m = &PDUNotificationRequest{}
+	case MsgTypePDUNotificationResponse:
+		m = &PDUNotificationResponse{}
+	case MsgTypePDUNotificationRejectRequest:
+		m = &PDUNotificationRejectRequest{}
+	case MsgTypePDUNotificationRejectResponse:
+		m = &PDUNotificationRejectResponse{}
 	case MsgTypeSendRoutingInfoRequest:
-		m = &SendRoutingInfoReq{}
+		m = &SendRoutingInfoRequest{}
 	case MsgTypeSendRoutingInfoResponse:
-		m = &SendRoutingInfoRes{}
+		m = &SendRoutingInfoResponse{}
 	case MsgTypeFailureReportRequest:
-		m = &FailureReportReq{}
+		m = &FailureReportRequest{}
 	case MsgTypeFailureReportResponse:
-		m = &FailureReportRes{}
-	case MsgTypeNoteMsPresentRequest:
-		m = &NoteMsPresentReq{}
-	case MsgTypeNoteMsPresentResponse:
-		m = &NoteMsPresentRes{}
+		m = &FailureReportResponse{}
+	case MsgTypeNoteMSPresentRequest:
+		m = &NoteMSPresentRequest{}
+	case MsgTypeNoteMSPresentResponse:
+		m = &NoteMSPresentResponse{}
 	case MsgTypeIdentificationRequest:
-		m = &IdentificationReq{}
+		m = &IdentificationRequest{}
 	case MsgTypeIdentificationResponse:
-		m = &IdentificationRes{}
-	case MsgTypeSgsnContextRequest:
-		m = &SgsnContextReq{}
-	case MsgTypeSgsnContextResponse:
-		m = &SgsnContextRes{}
-	case MsgTypeSgsnContextAcknowledge:
-		m = &SgsnContextAck{}
+		m = &IdentificationResponse{}
+	case MsgTypeSGSNContextRequest:
+		m = &SGSNContextRequest{}
+	case MsgTypeSGSNContextResponse:
+		m = &SGSNContextResponse{}
+	case MsgTypeSGSNContextAcknowledge:
+		m = &SGSNContextAcknowledge{}
+	case MsgTypeForwardRelocationRequest:
+		m = &ForwardRelocationRequest{}
+	case MsgTypeForwardRelocationResponse:
+		m = &ForwardRelocationResponse{}
+	case MsgTypeForwardRelocationComplete:
+		m = &ForwardRelocationComplete{}
+	case MsgTypeRelocationCancelRequest:
+		m = &RelocationCancelRequest{}
+	case MsgTypeRelocationCancelResponse:
+		m = &RelocationCancelResponse{}
+	case MsgTypeForwardSRNSContext:
+		m = &ForwardSRNSContext{}
+	case MsgTypeForwardRelocationCompleteAcknowledge:
+		m = &ForwardRelocationCompleteAcknowledge{}
+	case MsgTypeForwardSRNSContextAcknowledge:
+		m = &ForwardSRNSContextAcknowledge{}
+	case MsgTypeRANInformationRelay:
+		m = &RANInformationRelay{}
+	case MsgTypeMBMSNotificationRequest:
+		m = &MBMSNotificationRequest{}
+	case MsgTypeMBMSNotificationResponse:
+		m = &MBMSNotificationResponse{}
+	case MsgTypeMBMSNotificationRejectRequest:
+		m = &MBMSNotificationRejectRequest{}
+	case MsgTypeMBMSNotificationRejectResponse:
+		m = &MBMSNotificationRejectResponse{}
+	case MsgTypeCreateMBMSContextRequest:
+		m = &CreateMBMSContextRequest{}
+	case MsgTypeCreateMBMSContextResponse:
+		m = &CreateMBMSContextResponse{}
+	case MsgTypeUpdateMBMSContextRequest:
+		m = &UpdateMBMSContextRequest{}
+	case MsgTypeUpdateMBMSContextResponse:
+		m = &UpdateMBMSContextResponse{}
+	case MsgTypeDeleteMBMSContextRequest:
+		m = &DeleteMBMSContextRequest{}
+	case MsgTypeDeleteMBMSContextResponse:
+		m = &DeleteMBMSContextResponse{}
+	case MsgTypeMBMSRegistrationRequest:
+		m = &MBMSRegistrationRequest{}
+	case MsgTypeMBMSRegistrationResponse:
+		m = &MBMSRegistrationResponse{}
+	case MsgTypeMBMSDeRegistrationRequest:
+		m = &MBMSDeRegistrationRequest{}
+	case MsgTypeMBMSDeRegistrationResponse:
+		m = &MBMSDeRegistrationResponse{}
+	case MsgTypeMBMSSessionStartRequest:
+		m = &MBMSSessionStartRequest{}
+	case MsgTypeMBMSSessionStartResponse:
+		m = &MBMSSessionStartResponse{}
+	case MsgTypeMBMSSessionStopRequest:
+		m = &MBMSSessionStopRequest{}
+	case MsgTypeMBMSSessionStopResponse:
+		m = &MBMSSessionStopResponse{}
+	case MsgTypeMBMSSessionUpdateRequest:
+		m = &MBMSSessionUpdateRequest{}
+	case MsgTypeMBMSSessionUpdateResponse:
+		m = &MBMSSessionUpdateResponse{}
 	case MsgTypeDataRecordTransferRequest:
-		m = &DataRecordTransferReq{}
+		m = &DataRecordTransferRequest{}
 	case MsgTypeDataRecordTransferResponse:
-		m = &DataRecordTransferRes{}
-	*/
+		m = &DataRecordTransferResponse{}
+	case MsgTypeSupportedExtensionHeadersNotification:
+		m = &SupportedExtensionHeadersNotification{}
+	case MsgTypeEndMarker:
+		m = &EndMarker{}
 	case MsgTypeTPDU:
 		m = &TPDU{}
 	default:
@@ -0,0 +1,44 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+	"github.com/wmnsk/go-gtp/gtpv1/testutils"
+)
+
+func TestNodeAliveRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewNodeAliveRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.New(ie.ChargingGatewayAddress, []byte{0x01, 0x01, 0x01, 0x01}),
+				ie.New(ie.ChargingGatewayAddress, []byte{0x02, 0x02, 0x02, 0x02}),
+			),
+			Serialized: []byte{
+				// Header
+				0x32, 0x04, 0x00, 0x12, 0x11, 0x22, 0x33, 0x44,
+				0x00, 0x01, 0x00, 0x00,
+				// NodeAddress
+				0xfb, 0x00, 0x04, 0x01, 0x01, 0x01, 0x01,
+				// AlternativeNodeAddress
+				0xfb, 0x00, 0x04, 0x02, 0x02, 0x02, 0x02,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseNodeAliveRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,177 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// NoteMSPresentRequest is a NoteMSPresentRequest Header and its IEs above.
+type NoteMSPresentRequest struct {
+	*Header
+	IMSI             *ie.IE
+	GSNAddress       *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewNoteMSPresentRequest creates a new GTPv1 NoteMSPresentRequest.
+func NewNoteMSPresentRequest(teid uint32, seq uint16, ies ...*ie.IE) *NoteMSPresentRequest {
+	n := &NoteMSPresentRequest{
+		Header: NewHeader(0x32, MsgTypeNoteMSPresentRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			n.IMSI = i
+		case ie.GSNAddress:
+			n.GSNAddress = i
+		case ie.PrivateExtension:
+			n.PrivateExtension = i
+		default:
+			n.AdditionalIEs = append(n.AdditionalIEs, i)
+		}
+	}
+
+	n.SetLength()
+	return n
+}
+
+// Marshal returns the byte sequence generated from a NoteMSPresentRequest.
+func (n *NoteMSPresentRequest) Marshal() ([]byte, error) {
+	b := make([]byte, n.MarshalLen())
+	if err := n.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (n *NoteMSPresentRequest) MarshalTo(b []byte) error {
+	if len(b) < n.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	n.Header.Payload = make([]byte, n.MarshalLen()-n.Header.MarshalLen())
+
+	offset := 0
+	if ie := n.IMSI; ie != nil {
+		if err := ie.MarshalTo(n.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := n.GSNAddress; ie != nil {
+		if err := ie.MarshalTo(n.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := n.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(n.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range n.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(n.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	n.Header.SetLength()
+	return n.Header.MarshalTo(b)
+}
+
+// ParseNoteMSPresentRequest decodes a given byte sequence as a NoteMSPresentRequest.
+func ParseNoteMSPresentRequest(b []byte) (*NoteMSPresentRequest, error) {
+	n := &NoteMSPresentRequest{}
+	if err := n.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a NoteMSPresentRequest.
+func (n *NoteMSPresentRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	n.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(n.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(n.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			n.IMSI = i
+		case ie.GSNAddress:
+			n.GSNAddress = i
+		case ie.PrivateExtension:
+			n.PrivateExtension = i
+		default:
+			n.AdditionalIEs = append(n.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (n *NoteMSPresentRequest) MarshalLen() int {
+	l := n.Header.MarshalLen() - len(n.Header.Payload)
+
+	if ie := n.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := n.GSNAddress; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := n.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range n.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (n *NoteMSPresentRequest) SetLength() {
+	n.Length = uint16(n.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (n *NoteMSPresentRequest) MessageTypeName() string {
+	return "Note MS GPRS Present Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (n *NoteMSPresentRequest) TEID() uint32 {
+	return n.Header.TEID
+}
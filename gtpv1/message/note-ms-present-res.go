@@ -0,0 +1,163 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// NoteMSPresentResponse is a NoteMSPresentResponse Header and its IEs above.
+type NoteMSPresentResponse struct {
+	*Header
+	Cause            *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewNoteMSPresentResponse creates a new GTPv1 NoteMSPresentResponse.
+func NewNoteMSPresentResponse(teid uint32, seq uint16, ies ...*ie.IE) *NoteMSPresentResponse {
+	n := &NoteMSPresentResponse{
+		Header: NewHeader(0x32, MsgTypeNoteMSPresentResponse, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			n.Cause = i
+		case ie.PrivateExtension:
+			n.PrivateExtension = i
+		default:
+			n.AdditionalIEs = append(n.AdditionalIEs, i)
+		}
+	}
+
+	n.SetLength()
+	return n
+}
+
+// Marshal returns the byte sequence generated from a NoteMSPresentResponse.
+func (n *NoteMSPresentResponse) Marshal() ([]byte, error) {
+	b := make([]byte, n.MarshalLen())
+	if err := n.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (n *NoteMSPresentResponse) MarshalTo(b []byte) error {
+	if len(b) < n.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	n.Header.Payload = make([]byte, n.MarshalLen()-n.Header.MarshalLen())
+
+	offset := 0
+	if ie := n.Cause; ie != nil {
+		if err := ie.MarshalTo(n.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := n.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(n.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range n.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(n.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	n.Header.SetLength()
+	return n.Header.MarshalTo(b)
+}
+
+// ParseNoteMSPresentResponse decodes a given byte sequence as a NoteMSPresentResponse.
+func ParseNoteMSPresentResponse(b []byte) (*NoteMSPresentResponse, error) {
+	n := &NoteMSPresentResponse{}
+	if err := n.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a NoteMSPresentResponse.
+func (n *NoteMSPresentResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	n.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(n.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(n.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			n.Cause = i
+		case ie.PrivateExtension:
+			n.PrivateExtension = i
+		default:
+			n.AdditionalIEs = append(n.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (n *NoteMSPresentResponse) MarshalLen() int {
+	l := n.Header.MarshalLen() - len(n.Header.Payload)
+
+	if ie := n.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := n.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range n.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (n *NoteMSPresentResponse) SetLength() {
+	n.Length = uint16(n.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (n *NoteMSPresentResponse) MessageTypeName() string {
+	return "Note MS GPRS Present Response"
+}
+
+// TEID returns the TEID in human-readable string.
+func (n *NoteMSPresentResponse) TEID() uint32 {
+	return n.Header.TEID
+}
@@ -0,0 +1,233 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// PDUNotificationRequest is a PDUNotificationRequest Header and its IEs above.
+type PDUNotificationRequest struct {
+	*Header
+	IMSI                 *ie.IE
+	TEIDCPlane           *ie.IE
+	EndUserAddress       *ie.IE
+	APN                  *ie.IE
+	PCO                  *ie.IE
+	GGSNAddressForCPlane *ie.IE
+	PrivateExtension     *ie.IE
+	AdditionalIEs        []*ie.IE
+}
+
+// NewPDUNotificationRequest creates a new GTPv1 PDUNotificationRequest.
+func NewPDUNotificationRequest(teid uint32, seq uint16, ies ...*ie.IE) *PDUNotificationRequest {
+	p := &PDUNotificationRequest{
+		Header: NewHeader(0x32, MsgTypePDUNotificationRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			p.IMSI = i
+		case ie.TEIDCPlane:
+			p.TEIDCPlane = i
+		case ie.EndUserAddress:
+			p.EndUserAddress = i
+		case ie.AccessPointName:
+			p.APN = i
+		case ie.ProtocolConfigurationOptions:
+			p.PCO = i
+		case ie.GSNAddress:
+			p.GGSNAddressForCPlane = i
+		case ie.PrivateExtension:
+			p.PrivateExtension = i
+		default:
+			p.AdditionalIEs = append(p.AdditionalIEs, i)
+		}
+	}
+
+	p.SetLength()
+	return p
+}
+
+// Marshal returns the byte sequence generated from a PDUNotificationRequest.
+func (p *PDUNotificationRequest) Marshal() ([]byte, error) {
+	b := make([]byte, p.MarshalLen())
+	if err := p.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (p *PDUNotificationRequest) MarshalTo(b []byte) error {
+	if len(b) < p.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	p.Header.Payload = make([]byte, p.MarshalLen()-p.Header.MarshalLen())
+
+	offset := 0
+	if ie := p.IMSI; ie != nil {
+		if err := ie.MarshalTo(p.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := p.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(p.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := p.EndUserAddress; ie != nil {
+		if err := ie.MarshalTo(p.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := p.APN; ie != nil {
+		if err := ie.MarshalTo(p.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := p.PCO; ie != nil {
+		if err := ie.MarshalTo(p.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := p.GGSNAddressForCPlane; ie != nil {
+		if err := ie.MarshalTo(p.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := p.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(p.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range p.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(p.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	p.Header.SetLength()
+	return p.Header.MarshalTo(b)
+}
+
+// ParsePDUNotificationRequest decodes a given byte sequence as a PDUNotificationRequest.
+func ParsePDUNotificationRequest(b []byte) (*PDUNotificationRequest, error) {
+	p := &PDUNotificationRequest{}
+	if err := p.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a PDUNotificationRequest.
+func (p *PDUNotificationRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	p.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(p.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(p.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			p.IMSI = i
+		case ie.TEIDCPlane:
+			p.TEIDCPlane = i
+		case ie.EndUserAddress:
+			p.EndUserAddress = i
+		case ie.AccessPointName:
+			p.APN = i
+		case ie.ProtocolConfigurationOptions:
+			p.PCO = i
+		case ie.GSNAddress:
+			p.GGSNAddressForCPlane = i
+		case ie.PrivateExtension:
+			p.PrivateExtension = i
+		default:
+			p.AdditionalIEs = append(p.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (p *PDUNotificationRequest) MarshalLen() int {
+	l := p.Header.MarshalLen() - len(p.Header.Payload)
+
+	if ie := p.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := p.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := p.EndUserAddress; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := p.APN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := p.PCO; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := p.GGSNAddressForCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := p.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range p.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (p *PDUNotificationRequest) SetLength() {
+	p.Length = uint16(p.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (p *PDUNotificationRequest) MessageTypeName() string {
+	return "PDU Notification Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (p *PDUNotificationRequest) TEID() uint32 {
+	return p.Header.TEID
+}
@@ -0,0 +1,44 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+	"github.com/wmnsk/go-gtp/gtpv1/testutils"
+)
+
+func TestRANInformationRelay(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewRANInformationRelay(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewRANTransparentContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+				ie.New(ie.RIMRoutingAddress, []byte{0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x33, 0x33}),
+			),
+			Serialized: []byte{
+				// Header
+				0x32, 0x46, 0x00, 0x16, 0x11, 0x22, 0x33, 0x44,
+				0x00, 0x01, 0x00, 0x00,
+				// RANTransparentContainer
+				0x90, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef,
+				// RIMRoutingAddress
+				0x9e, 0x00, 0x08, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x33, 0x33,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseRANInformationRelay(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
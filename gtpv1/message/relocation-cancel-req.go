@@ -0,0 +1,205 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// RelocationCancelRequest is a RelocationCancelRequest Header and its IEs above.
+type RelocationCancelRequest struct {
+	*Header
+	IMSI                *ie.IE
+	IMEISV              *ie.IE
+	ExtendedCommonFlags *ie.IE
+	ExtendedRANAPCause  *ie.IE
+	PrivateExtension    *ie.IE
+	AdditionalIEs       []*ie.IE
+}
+
+// NewRelocationCancelRequest creates a new GTPv1 RelocationCancelRequest.
+func NewRelocationCancelRequest(teid uint32, seq uint16, ies ...*ie.IE) *RelocationCancelRequest {
+	r := &RelocationCancelRequest{
+		Header: NewHeader(0x32, MsgTypeRelocationCancelRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			r.IMSI = i
+		case ie.IMEISV:
+			r.IMEISV = i
+		case ie.ExtendedCommonFlags:
+			r.ExtendedCommonFlags = i
+		case ie.ExtendedRANAPCause:
+			r.ExtendedRANAPCause = i
+		case ie.PrivateExtension:
+			r.PrivateExtension = i
+		default:
+			r.AdditionalIEs = append(r.AdditionalIEs, i)
+		}
+	}
+
+	r.SetLength()
+	return r
+}
+
+// Marshal returns the byte sequence generated from a RelocationCancelRequest.
+func (r *RelocationCancelRequest) Marshal() ([]byte, error) {
+	b := make([]byte, r.MarshalLen())
+	if err := r.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (r *RelocationCancelRequest) MarshalTo(b []byte) error {
+	if len(b) < r.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	r.Header.Payload = make([]byte, r.MarshalLen()-r.Header.MarshalLen())
+
+	offset := 0
+	if ie := r.IMSI; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.IMEISV; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.ExtendedCommonFlags; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.ExtendedRANAPCause; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range r.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(r.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	r.Header.SetLength()
+	return r.Header.MarshalTo(b)
+}
+
+// ParseRelocationCancelRequest decodes a given byte sequence as a RelocationCancelRequest.
+func ParseRelocationCancelRequest(b []byte) (*RelocationCancelRequest, error) {
+	r := &RelocationCancelRequest{}
+	if err := r.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a RelocationCancelRequest.
+func (r *RelocationCancelRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	r.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(r.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(r.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			r.IMSI = i
+		case ie.IMEISV:
+			r.IMEISV = i
+		case ie.ExtendedCommonFlags:
+			r.ExtendedCommonFlags = i
+		case ie.ExtendedRANAPCause:
+			r.ExtendedRANAPCause = i
+		case ie.PrivateExtension:
+			r.PrivateExtension = i
+		default:
+			r.AdditionalIEs = append(r.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (r *RelocationCancelRequest) MarshalLen() int {
+	l := r.Header.MarshalLen() - len(r.Header.Payload)
+
+	if ie := r.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.IMEISV; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.ExtendedCommonFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.ExtendedRANAPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range r.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (r *RelocationCancelRequest) SetLength() {
+	r.Length = uint16(r.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (r *RelocationCancelRequest) MessageTypeName() string {
+	return "Relocation Cancel Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (r *RelocationCancelRequest) TEID() uint32 {
+	return r.Header.TEID
+}
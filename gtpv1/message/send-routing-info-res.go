@@ -0,0 +1,219 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// SendRoutingInfoResponse is a SendRoutingInfoResponse Header and its IEs above.
+type SendRoutingInfoResponse struct {
+	*Header
+	Cause                *ie.IE
+	IMSI                 *ie.IE
+	MAPCause             *ie.IE
+	MSNotReachableReason *ie.IE
+	GSNAddress           *ie.IE
+	PrivateExtension     *ie.IE
+	AdditionalIEs        []*ie.IE
+}
+
+// NewSendRoutingInfoResponse creates a new GTPv1 SendRoutingInfoResponse.
+func NewSendRoutingInfoResponse(teid uint32, seq uint16, ies ...*ie.IE) *SendRoutingInfoResponse {
+	s := &SendRoutingInfoResponse{
+		Header: NewHeader(0x32, MsgTypeSendRoutingInfoResponse, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			s.Cause = i
+		case ie.IMSI:
+			s.IMSI = i
+		case ie.MAPCause:
+			s.MAPCause = i
+		case ie.MSNotReachableReason:
+			s.MSNotReachableReason = i
+		case ie.GSNAddress:
+			s.GSNAddress = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	s.SetLength()
+	return s
+}
+
+// Marshal returns the byte sequence generated from a SendRoutingInfoResponse.
+func (s *SendRoutingInfoResponse) Marshal() ([]byte, error) {
+	b := make([]byte, s.MarshalLen())
+	if err := s.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (s *SendRoutingInfoResponse) MarshalTo(b []byte) error {
+	if len(b) < s.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	s.Header.Payload = make([]byte, s.MarshalLen()-s.Header.MarshalLen())
+
+	offset := 0
+	if ie := s.Cause; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.IMSI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.MAPCause; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.MSNotReachableReason; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.GSNAddress; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(s.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	s.Header.SetLength()
+	return s.Header.MarshalTo(b)
+}
+
+// ParseSendRoutingInfoResponse decodes a given byte sequence as a SendRoutingInfoResponse.
+func ParseSendRoutingInfoResponse(b []byte) (*SendRoutingInfoResponse, error) {
+	s := &SendRoutingInfoResponse{}
+	if err := s.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a SendRoutingInfoResponse.
+func (s *SendRoutingInfoResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	s.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(s.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(s.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			s.Cause = i
+		case ie.IMSI:
+			s.IMSI = i
+		case ie.MAPCause:
+			s.MAPCause = i
+		case ie.MSNotReachableReason:
+			s.MSNotReachableReason = i
+		case ie.GSNAddress:
+			s.GSNAddress = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (s *SendRoutingInfoResponse) MarshalLen() int {
+	l := s.Header.MarshalLen() - len(s.Header.Payload)
+
+	if ie := s.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.MAPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.MSNotReachableReason; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.GSNAddress; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (s *SendRoutingInfoResponse) SetLength() {
+	s.Length = uint16(s.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (s *SendRoutingInfoResponse) MessageTypeName() string {
+	return "Send Routeing Information for GPRS Response"
+}
+
+// TEID returns the TEID in human-readable string.
+func (s *SendRoutingInfoResponse) TEID() uint32 {
+	return s.Header.TEID
+}
@@ -0,0 +1,219 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// SGSNContextAcknowledge is a SGSNContextAcknowledge Header and its IEs above.
+type SGSNContextAcknowledge struct {
+	*Header
+	Cause                     *ie.IE
+	TEIDDataII                *ie.IE
+	SGSNAddressForUserTraffic *ie.IE
+	SGSNNumber                *ie.IE
+	NodeIdentifier            *ie.IE
+	PrivateExtension          *ie.IE
+	AdditionalIEs             []*ie.IE
+}
+
+// NewSGSNContextAcknowledge creates a new GTPv1 SGSNContextAcknowledge.
+func NewSGSNContextAcknowledge(teid uint32, seq uint16, ies ...*ie.IE) *SGSNContextAcknowledge {
+	s := &SGSNContextAcknowledge{
+		Header: NewHeader(0x32, MsgTypeSGSNContextAcknowledge, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			s.Cause = i
+		case ie.TEIDDataII:
+			s.TEIDDataII = i
+		case ie.GSNAddress:
+			s.SGSNAddressForUserTraffic = i
+		case ie.SGSNNumber:
+			s.SGSNNumber = i
+		case ie.NodeIdentifier:
+			s.NodeIdentifier = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	s.SetLength()
+	return s
+}
+
+// Marshal returns the byte sequence generated from a SGSNContextAcknowledge.
+func (s *SGSNContextAcknowledge) Marshal() ([]byte, error) {
+	b := make([]byte, s.MarshalLen())
+	if err := s.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (s *SGSNContextAcknowledge) MarshalTo(b []byte) error {
+	if len(b) < s.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	s.Header.Payload = make([]byte, s.MarshalLen()-s.Header.MarshalLen())
+
+	offset := 0
+	if ie := s.Cause; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.TEIDDataII; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SGSNAddressForUserTraffic; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SGSNNumber; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.NodeIdentifier; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(s.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	s.Header.SetLength()
+	return s.Header.MarshalTo(b)
+}
+
+// ParseSGSNContextAcknowledge decodes a given byte sequence as a SGSNContextAcknowledge.
+func ParseSGSNContextAcknowledge(b []byte) (*SGSNContextAcknowledge, error) {
+	s := &SGSNContextAcknowledge{}
+	if err := s.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a SGSNContextAcknowledge.
+func (s *SGSNContextAcknowledge) UnmarshalBinary(b []byte) error {
+	var err error
+	s.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(s.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(s.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			s.Cause = i
+		case ie.TEIDDataII:
+			s.TEIDDataII = i
+		case ie.GSNAddress:
+			s.SGSNAddressForUserTraffic = i
+		case ie.SGSNNumber:
+			s.SGSNNumber = i
+		case ie.NodeIdentifier:
+			s.NodeIdentifier = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (s *SGSNContextAcknowledge) MarshalLen() int {
+	l := s.Header.MarshalLen() - len(s.Header.Payload)
+
+	if ie := s.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.TEIDDataII; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SGSNAddressForUserTraffic; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SGSNNumber; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.NodeIdentifier; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (s *SGSNContextAcknowledge) SetLength() {
+	s.Length = uint16(s.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (s *SGSNContextAcknowledge) MessageTypeName() string {
+	return "SGSN Context Acknowledge"
+}
+
+// TEID returns the TEID in human-readable string.
+func (s *SGSNContextAcknowledge) TEID() uint32 {
+	return s.Header.TEID
+}
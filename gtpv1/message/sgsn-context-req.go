@@ -0,0 +1,325 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// SGSNContextRequest is a SGSNContextRequest Header and its IEs above.
+type SGSNContextRequest struct {
+	*Header
+	IMSI                                  *ie.IE
+	RAI                                   *ie.IE
+	TLLI                                  *ie.IE
+	PacketTMSI                            *ie.IE
+	PTMSISignature                        *ie.IE
+	MSValidated                           *ie.IE
+	TEIDCPlane                            *ie.IE
+	SGSNAddressForControlPlane            *ie.IE
+	AlternativeSGSNAddressForControlPlane *ie.IE
+	SGSNNumber                            *ie.IE
+	RATType                               *ie.IE
+	HopCounter                            *ie.IE
+	PrivateExtension                      *ie.IE
+	AdditionalIEs                         []*ie.IE
+}
+
+// NewSGSNContextRequest creates a new GTPv1 SGSNContextRequest.
+func NewSGSNContextRequest(teid uint32, seq uint16, ies ...*ie.IE) *SGSNContextRequest {
+	s := &SGSNContextRequest{
+		Header: NewHeader(0x32, MsgTypeSGSNContextRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			s.IMSI = i
+		case ie.RouteingAreaIdentity:
+			s.RAI = i
+		case ie.TemporaryLogicalLinkIdentity:
+			s.TLLI = i
+		case ie.PacketTMSI:
+			s.PacketTMSI = i
+		case ie.PTMSISignature:
+			s.PTMSISignature = i
+		case ie.MSValidated:
+			s.MSValidated = i
+		case ie.TEIDCPlane:
+			s.TEIDCPlane = i
+		case ie.GSNAddress:
+			if s.SGSNAddressForControlPlane == nil {
+				s.SGSNAddressForControlPlane = i
+			} else if s.AlternativeSGSNAddressForControlPlane == nil {
+				s.AlternativeSGSNAddressForControlPlane = i
+			} else {
+				s.AdditionalIEs = append(s.AdditionalIEs, i)
+			}
+		case ie.SGSNNumber:
+			s.SGSNNumber = i
+		case ie.RATType:
+			s.RATType = i
+		case ie.HopCounter:
+			s.HopCounter = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	s.SetLength()
+	return s
+}
+
+// Marshal returns the byte sequence generated from a SGSNContextRequest.
+func (s *SGSNContextRequest) Marshal() ([]byte, error) {
+	b := make([]byte, s.MarshalLen())
+	if err := s.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (s *SGSNContextRequest) MarshalTo(b []byte) error {
+	if len(b) < s.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	s.Header.Payload = make([]byte, s.MarshalLen()-s.Header.MarshalLen())
+
+	offset := 0
+	if ie := s.IMSI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.RAI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.TLLI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PacketTMSI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PTMSISignature; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.MSValidated; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.AlternativeSGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SGSNNumber; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.RATType; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.HopCounter; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(s.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	s.Header.SetLength()
+	return s.Header.MarshalTo(b)
+}
+
+// ParseSGSNContextRequest decodes a given byte sequence as a SGSNContextRequest.
+func ParseSGSNContextRequest(b []byte) (*SGSNContextRequest, error) {
+	s := &SGSNContextRequest{}
+	if err := s.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a SGSNContextRequest.
+func (s *SGSNContextRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	s.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(s.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(s.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			s.IMSI = i
+		case ie.RouteingAreaIdentity:
+			s.RAI = i
+		case ie.TemporaryLogicalLinkIdentity:
+			s.TLLI = i
+		case ie.PacketTMSI:
+			s.PacketTMSI = i
+		case ie.PTMSISignature:
+			s.PTMSISignature = i
+		case ie.MSValidated:
+			s.MSValidated = i
+		case ie.TEIDCPlane:
+			s.TEIDCPlane = i
+		case ie.GSNAddress:
+			if s.SGSNAddressForControlPlane == nil {
+				s.SGSNAddressForControlPlane = i
+			} else if s.AlternativeSGSNAddressForControlPlane == nil {
+				s.AlternativeSGSNAddressForControlPlane = i
+			} else {
+				s.AdditionalIEs = append(s.AdditionalIEs, i)
+			}
+		case ie.SGSNNumber:
+			s.SGSNNumber = i
+		case ie.RATType:
+			s.RATType = i
+		case ie.HopCounter:
+			s.HopCounter = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (s *SGSNContextRequest) MarshalLen() int {
+	l := s.Header.MarshalLen() - len(s.Header.Payload)
+
+	if ie := s.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.RAI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.TLLI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PacketTMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PTMSISignature; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.MSValidated; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.AlternativeSGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SGSNNumber; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.RATType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.HopCounter; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (s *SGSNContextRequest) SetLength() {
+	s.Length = uint16(s.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (s *SGSNContextRequest) MessageTypeName() string {
+	return "SGSN Context Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (s *SGSNContextRequest) TEID() uint32 {
+	return s.Header.TEID
+}
@@ -0,0 +1,577 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// SGSNContextResponse is a SGSNContextResponse Header and its IEs above.
+type SGSNContextResponse struct {
+	*Header
+	Cause                                 *ie.IE
+	IMSI                                  *ie.IE
+	TEIDCPlane                            *ie.IE
+	RABContext                            *ie.IE
+	RadioPrioritySMS                      *ie.IE
+	RadioPriority                         *ie.IE
+	PacketFlowID                          *ie.IE
+	ChargingCharacteristics               *ie.IE
+	RadioPriorityLCS                      *ie.IE
+	MMContext                             *ie.IE
+	PDPContext                            *ie.IE
+	SGSNAddressForControlPlane            *ie.IE
+	PDPContextPrioritization              *ie.IE
+	MBMSUEContext                         *ie.IE
+	SubscribedRFSPIndex                   *ie.IE
+	RFSPIndexInUse                        *ie.IE
+	CoLocatedGGSNPGWFQDN                  *ie.IE
+	EvolvedARPII                          *ie.IE
+	ExtendedCommonFlags                   *ie.IE
+	UENetworkCapability                   *ie.IE
+	UEAMBR                                *ie.IE
+	APNAMBRWithNSAPI                      *ie.IE
+	SignallingPriorityIndicationWithNSAPI *ie.IE
+	HigherBitratesThan16MbpsFlag          *ie.IE
+	SelectionModeWithNSAPI                *ie.IE
+	LHNIDWithNSAPI                        *ie.IE
+	UEUsageType                           *ie.IE
+	ExtendedCommonFlagsII                 *ie.IE
+	SCEFPDNConnection                     *ie.IE
+	IOVUpdatesCounter                     *ie.IE
+	PrivateExtension                      *ie.IE
+	AdditionalIEs                         []*ie.IE
+}
+
+// NewSGSNContextResponse creates a new GTPv1 SGSNContextResponse.
+func NewSGSNContextResponse(teid uint32, seq uint16, ies ...*ie.IE) *SGSNContextResponse {
+	s := &SGSNContextResponse{
+		Header: NewHeader(0x32, MsgTypeSGSNContextResponse, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			s.Cause = i
+		case ie.IMSI:
+			s.IMSI = i
+		case ie.TEIDCPlane:
+			s.TEIDCPlane = i
+		case ie.RABContext:
+			s.RABContext = i
+		case ie.RadioPrioritySMS:
+			s.RadioPrioritySMS = i
+		case ie.RadioPriority:
+			s.RadioPriority = i
+		case ie.PacketFlowID:
+			s.PacketFlowID = i
+		case ie.ChargingCharacteristics:
+			s.ChargingCharacteristics = i
+		case ie.RadioPriorityLCS:
+			s.RadioPriorityLCS = i
+		case ie.MMContext:
+			s.MMContext = i
+		case ie.PDPContext:
+			s.PDPContext = i
+		case ie.GSNAddress:
+			s.SGSNAddressForControlPlane = i
+		case ie.PDPContextPrioritization:
+			s.PDPContextPrioritization = i
+		case ie.MBMSUEContext:
+			s.MBMSUEContext = i
+		case ie.RFSPIndex:
+			if s.SubscribedRFSPIndex == nil {
+				s.SubscribedRFSPIndex = i
+			} else if s.RFSPIndexInUse == nil {
+				s.RFSPIndexInUse = i
+			} else {
+				s.AdditionalIEs = append(s.AdditionalIEs, i)
+			}
+		case ie.FullyQualifiedDomainName:
+			s.CoLocatedGGSNPGWFQDN = i
+		case ie.EvolvedAllocationRetentionPriorityII:
+			s.EvolvedARPII = i
+		case ie.ExtendedCommonFlags:
+			s.ExtendedCommonFlags = i
+		case ie.UENetworkCapability:
+			s.UENetworkCapability = i
+		case ie.UEAMBR:
+			s.UEAMBR = i
+		case ie.APNAMBRWithNSAPI:
+			s.APNAMBRWithNSAPI = i
+		case ie.SignallingPriorityIndicationWithNSAPI:
+			s.SignallingPriorityIndicationWithNSAPI = i
+		case ie.HigherBitratesThan16MbpsFlag:
+			s.HigherBitratesThan16MbpsFlag = i
+		case ie.SelectionModeWithNSAPI:
+			s.SelectionModeWithNSAPI = i
+		case ie.LHNIDWithNSAPI:
+			s.LHNIDWithNSAPI = i
+		case ie.UEUsageType:
+			s.UEUsageType = i
+		case ie.ExtendedCommonFlagsII:
+			s.ExtendedCommonFlagsII = i
+		case ie.SCEFPDNConnection:
+			s.SCEFPDNConnection = i
+		case ie.IOVUpdatesCounter:
+			s.IOVUpdatesCounter = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	s.SetLength()
+	return s
+}
+
+// Marshal returns the byte sequence generated from a SGSNContextResponse.
+func (s *SGSNContextResponse) Marshal() ([]byte, error) {
+	b := make([]byte, s.MarshalLen())
+	if err := s.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (s *SGSNContextResponse) MarshalTo(b []byte) error {
+	if len(b) < s.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	s.Header.Payload = make([]byte, s.MarshalLen()-s.Header.MarshalLen())
+
+	offset := 0
+	if ie := s.Cause; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.IMSI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.RABContext; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.RadioPrioritySMS; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.RadioPriority; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PacketFlowID; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.ChargingCharacteristics; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.RadioPriorityLCS; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.MMContext; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PDPContext; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PDPContextPrioritization; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.MBMSUEContext; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SubscribedRFSPIndex; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.RFSPIndexInUse; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.CoLocatedGGSNPGWFQDN; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.EvolvedARPII; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.ExtendedCommonFlags; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.UENetworkCapability; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.UEAMBR; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.APNAMBRWithNSAPI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SignallingPriorityIndicationWithNSAPI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.HigherBitratesThan16MbpsFlag; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SelectionModeWithNSAPI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.LHNIDWithNSAPI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.UEUsageType; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.ExtendedCommonFlagsII; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SCEFPDNConnection; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.IOVUpdatesCounter; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(s.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	s.Header.SetLength()
+	return s.Header.MarshalTo(b)
+}
+
+// ParseSGSNContextResponse decodes a given byte sequence as a SGSNContextResponse.
+func ParseSGSNContextResponse(b []byte) (*SGSNContextResponse, error) {
+	s := &SGSNContextResponse{}
+	if err := s.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a SGSNContextResponse.
+func (s *SGSNContextResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	s.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(s.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(s.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			s.Cause = i
+		case ie.IMSI:
+			s.IMSI = i
+		case ie.TEIDCPlane:
+			s.TEIDCPlane = i
+		case ie.RABContext:
+			s.RABContext = i
+		case ie.RadioPrioritySMS:
+			s.RadioPrioritySMS = i
+		case ie.RadioPriority:
+			s.RadioPriority = i
+		case ie.PacketFlowID:
+			s.PacketFlowID = i
+		case ie.ChargingCharacteristics:
+			s.ChargingCharacteristics = i
+		case ie.RadioPriorityLCS:
+			s.RadioPriorityLCS = i
+		case ie.MMContext:
+			s.MMContext = i
+		case ie.PDPContext:
+			s.PDPContext = i
+		case ie.GSNAddress:
+			s.SGSNAddressForControlPlane = i
+		case ie.PDPContextPrioritization:
+			s.PDPContextPrioritization = i
+		case ie.MBMSUEContext:
+			s.MBMSUEContext = i
+		case ie.RFSPIndex:
+			if s.SubscribedRFSPIndex == nil {
+				s.SubscribedRFSPIndex = i
+			} else if s.RFSPIndexInUse == nil {
+				s.RFSPIndexInUse = i
+			} else {
+				s.AdditionalIEs = append(s.AdditionalIEs, i)
+			}
+		case ie.FullyQualifiedDomainName:
+			s.CoLocatedGGSNPGWFQDN = i
+		case ie.EvolvedAllocationRetentionPriorityII:
+			s.EvolvedARPII = i
+		case ie.ExtendedCommonFlags:
+			s.ExtendedCommonFlags = i
+		case ie.UENetworkCapability:
+			s.UENetworkCapability = i
+		case ie.UEAMBR:
+			s.UEAMBR = i
+		case ie.APNAMBRWithNSAPI:
+			s.APNAMBRWithNSAPI = i
+		case ie.SignallingPriorityIndicationWithNSAPI:
+			s.SignallingPriorityIndicationWithNSAPI = i
+		case ie.HigherBitratesThan16MbpsFlag:
+			s.HigherBitratesThan16MbpsFlag = i
+		case ie.SelectionModeWithNSAPI:
+			s.SelectionModeWithNSAPI = i
+		case ie.LHNIDWithNSAPI:
+			s.LHNIDWithNSAPI = i
+		case ie.UEUsageType:
+			s.UEUsageType = i
+		case ie.ExtendedCommonFlagsII:
+			s.ExtendedCommonFlagsII = i
+		case ie.SCEFPDNConnection:
+			s.SCEFPDNConnection = i
+		case ie.IOVUpdatesCounter:
+			s.IOVUpdatesCounter = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (s *SGSNContextResponse) MarshalLen() int {
+	l := s.Header.MarshalLen() - len(s.Header.Payload)
+
+	if ie := s.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.RABContext; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.RadioPrioritySMS; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.RadioPriority; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PacketFlowID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.ChargingCharacteristics; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.RadioPriorityLCS; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.MMContext; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PDPContext; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PDPContextPrioritization; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.MBMSUEContext; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SubscribedRFSPIndex; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.RFSPIndexInUse; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.CoLocatedGGSNPGWFQDN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.EvolvedARPII; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.ExtendedCommonFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.UENetworkCapability; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.UEAMBR; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.APNAMBRWithNSAPI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SignallingPriorityIndicationWithNSAPI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.HigherBitratesThan16MbpsFlag; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SelectionModeWithNSAPI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.LHNIDWithNSAPI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.UEUsageType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.ExtendedCommonFlagsII; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SCEFPDNConnection; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.IOVUpdatesCounter; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (s *SGSNContextResponse) SetLength() {
+	s.Length = uint16(s.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (s *SGSNContextResponse) MessageTypeName() string {
+	return "SGSN Context Response"
+}
+
+// TEID returns the TEID in human-readable string.
+func (s *SGSNContextResponse) TEID() uint32 {
+	return s.Header.TEID
+}
@@ -25,6 +25,15 @@ func NewTPDUWithSequence(teid uint32, seq uint16, payload []byte) *TPDU {
 	return t
 }
 
+// NewTPDUWithExtensionHeaders creates a new G-PDU message with Extension Headers.
+func NewTPDUWithExtensionHeaders(teid uint32, payload []byte, ehs ...*ExtensionHeader) *TPDU {
+	t := &TPDU{Header: NewHeader(0x30, MsgTypeTPDU, teid, 0, payload)}
+	t.AddExtensionHeaders(ehs...)
+
+	t.SetLength()
+	return t
+}
+
 // Marshal returns the byte sequence generated from a TPDU.
 func (t *TPDU) Marshal() ([]byte, error) {
 	b := make([]byte, t.MarshalLen())
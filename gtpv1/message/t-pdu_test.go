@@ -27,6 +27,18 @@ func TestTPDU(t *testing.T) {
 				0x32, 0xff, 0x00, 0x08, 0xde, 0xad, 0xbe, 0xef,
 				0x00, 0x01, 0x00, 0x00, 0xde, 0xad, 0xbe, 0xef,
 			},
+		}, {
+			Description: "With-ExtensionHeaders",
+			Structured: message.NewTPDUWithExtensionHeaders(
+				0xdeadbeef, []byte{0xde, 0xad, 0xbe, 0xef},
+				message.NewPDUSessionContainerExtensionHeader(message.PDUTypeDLPDUSessionInformation, 9, true),
+				message.NewUDPPortExtensionHeader(2152),
+			),
+			Serialized: []byte{
+				0x34, 0xff, 0x00, 0x10, 0xde, 0xad, 0xbe, 0xef,
+				0x00, 0x00, 0x00, 0x85, 0x01, 0x00, 0x49, 0x40,
+				0x01, 0x08, 0x68, 0x00, 0xde, 0xad, 0xbe, 0xef,
+			},
 		},
 	}
 
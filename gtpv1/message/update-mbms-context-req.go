@@ -0,0 +1,367 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// UpdateMBMSContextRequest is a UpdateMBMSContextRequest Header and its IEs above.
+type UpdateMBMSContextRequest struct {
+	*Header
+	RAI                                   *ie.IE
+	Recovery                              *ie.IE
+	TEIDCPlane                            *ie.IE
+	TraceReference                        *ie.IE
+	TraceType                             *ie.IE
+	SGSNAddressForControlPlane            *ie.IE
+	AlternativeSGSNAddressForControlPlane *ie.IE
+	TriggerID                             *ie.IE
+	OMCIdentity                           *ie.IE
+	RATType                               *ie.IE
+	UserLocationInformation               *ie.IE
+	MSTimeZone                            *ie.IE
+	AdditionalTraceInfo                   *ie.IE
+	EnhancedNSAPI                         *ie.IE
+	AdditionalMBMSTraceInfo               *ie.IE
+	PrivateExtension                      *ie.IE
+	AdditionalIEs                         []*ie.IE
+}
+
+// NewUpdateMBMSContextRequest creates a new GTPv1 UpdateMBMSContextRequest.
+func NewUpdateMBMSContextRequest(teid uint32, seq uint16, ies ...*ie.IE) *UpdateMBMSContextRequest {
+	u := &UpdateMBMSContextRequest{
+		Header: NewHeader(0x32, MsgTypeUpdateMBMSContextRequest, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.RouteingAreaIdentity:
+			u.RAI = i
+		case ie.Recovery:
+			u.Recovery = i
+		case ie.TEIDCPlane:
+			u.TEIDCPlane = i
+		case ie.TraceReference:
+			u.TraceReference = i
+		case ie.TraceType:
+			u.TraceType = i
+		case ie.GSNAddress:
+			if u.SGSNAddressForControlPlane == nil {
+				u.SGSNAddressForControlPlane = i
+			} else if u.AlternativeSGSNAddressForControlPlane == nil {
+				u.AlternativeSGSNAddressForControlPlane = i
+			} else {
+				u.AdditionalIEs = append(u.AdditionalIEs, i)
+			}
+		case ie.TriggerID:
+			u.TriggerID = i
+		case ie.OMCIdentity:
+			u.OMCIdentity = i
+		case ie.RATType:
+			u.RATType = i
+		case ie.UserLocationInformation:
+			u.UserLocationInformation = i
+		case ie.MSTimeZone:
+			u.MSTimeZone = i
+		case ie.AdditionalTraceInfo:
+			u.AdditionalTraceInfo = i
+		case ie.EnhancedNSAPI:
+			u.EnhancedNSAPI = i
+		case ie.AdditionalMBMSTraceInfo:
+			u.AdditionalMBMSTraceInfo = i
+		case ie.PrivateExtension:
+			u.PrivateExtension = i
+		default:
+			u.AdditionalIEs = append(u.AdditionalIEs, i)
+		}
+	}
+
+	u.SetLength()
+	return u
+}
+
+// Marshal returns the byte sequence generated from a UpdateMBMSContextRequest.
+func (u *UpdateMBMSContextRequest) Marshal() ([]byte, error) {
+	b := make([]byte, u.MarshalLen())
+	if err := u.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (u *UpdateMBMSContextRequest) MarshalTo(b []byte) error {
+	if len(b) < u.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	u.Header.Payload = make([]byte, u.MarshalLen()-u.Header.MarshalLen())
+
+	offset := 0
+	if ie := u.RAI; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.Recovery; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.TraceReference; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.TraceType; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.SGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.AlternativeSGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.TriggerID; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.OMCIdentity; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.RATType; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.UserLocationInformation; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.MSTimeZone; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.AdditionalTraceInfo; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.EnhancedNSAPI; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.AdditionalMBMSTraceInfo; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range u.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(u.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	u.Header.SetLength()
+	return u.Header.MarshalTo(b)
+}
+
+// ParseUpdateMBMSContextRequest decodes a given byte sequence as a UpdateMBMSContextRequest.
+func ParseUpdateMBMSContextRequest(b []byte) (*UpdateMBMSContextRequest, error) {
+	u := &UpdateMBMSContextRequest{}
+	if err := u.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a UpdateMBMSContextRequest.
+func (u *UpdateMBMSContextRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	u.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(u.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(u.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.RouteingAreaIdentity:
+			u.RAI = i
+		case ie.Recovery:
+			u.Recovery = i
+		case ie.TEIDCPlane:
+			u.TEIDCPlane = i
+		case ie.TraceReference:
+			u.TraceReference = i
+		case ie.TraceType:
+			u.TraceType = i
+		case ie.GSNAddress:
+			if u.SGSNAddressForControlPlane == nil {
+				u.SGSNAddressForControlPlane = i
+			} else if u.AlternativeSGSNAddressForControlPlane == nil {
+				u.AlternativeSGSNAddressForControlPlane = i
+			} else {
+				u.AdditionalIEs = append(u.AdditionalIEs, i)
+			}
+		case ie.TriggerID:
+			u.TriggerID = i
+		case ie.OMCIdentity:
+			u.OMCIdentity = i
+		case ie.RATType:
+			u.RATType = i
+		case ie.UserLocationInformation:
+			u.UserLocationInformation = i
+		case ie.MSTimeZone:
+			u.MSTimeZone = i
+		case ie.AdditionalTraceInfo:
+			u.AdditionalTraceInfo = i
+		case ie.EnhancedNSAPI:
+			u.EnhancedNSAPI = i
+		case ie.AdditionalMBMSTraceInfo:
+			u.AdditionalMBMSTraceInfo = i
+		case ie.PrivateExtension:
+			u.PrivateExtension = i
+		default:
+			u.AdditionalIEs = append(u.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (u *UpdateMBMSContextRequest) MarshalLen() int {
+	l := u.Header.MarshalLen() - len(u.Header.Payload)
+
+	if ie := u.RAI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.Recovery; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.TraceReference; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.TraceType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.SGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.AlternativeSGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.TriggerID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.OMCIdentity; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.RATType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.UserLocationInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.MSTimeZone; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.AdditionalTraceInfo; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.EnhancedNSAPI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.AdditionalMBMSTraceInfo; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range u.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (u *UpdateMBMSContextRequest) SetLength() {
+	u.Length = uint16(u.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (u *UpdateMBMSContextRequest) MessageTypeName() string {
+	return "Update MBMS Context Request"
+}
+
+// TEID returns the TEID in human-readable string.
+func (u *UpdateMBMSContextRequest) TEID() uint32 {
+	return u.Header.TEID
+}
@@ -0,0 +1,205 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// UpdateMBMSContextResponse is a UpdateMBMSContextResponse Header and its IEs above.
+type UpdateMBMSContextResponse struct {
+	*Header
+	Cause                      *ie.IE
+	Recovery                   *ie.IE
+	TEIDCPlane                 *ie.IE
+	GGSNAddressForControlPlane *ie.IE
+	PrivateExtension           *ie.IE
+	AdditionalIEs              []*ie.IE
+}
+
+// NewUpdateMBMSContextResponse creates a new GTPv1 UpdateMBMSContextResponse.
+func NewUpdateMBMSContextResponse(teid uint32, seq uint16, ies ...*ie.IE) *UpdateMBMSContextResponse {
+	u := &UpdateMBMSContextResponse{
+		Header: NewHeader(0x32, MsgTypeUpdateMBMSContextResponse, teid, seq, nil),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			u.Cause = i
+		case ie.Recovery:
+			u.Recovery = i
+		case ie.TEIDCPlane:
+			u.TEIDCPlane = i
+		case ie.GSNAddress:
+			u.GGSNAddressForControlPlane = i
+		case ie.PrivateExtension:
+			u.PrivateExtension = i
+		default:
+			u.AdditionalIEs = append(u.AdditionalIEs, i)
+		}
+	}
+
+	u.SetLength()
+	return u
+}
+
+// Marshal returns the byte sequence generated from a UpdateMBMSContextResponse.
+func (u *UpdateMBMSContextResponse) Marshal() ([]byte, error) {
+	b := make([]byte, u.MarshalLen())
+	if err := u.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (u *UpdateMBMSContextResponse) MarshalTo(b []byte) error {
+	if len(b) < u.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+	u.Header.Payload = make([]byte, u.MarshalLen()-u.Header.MarshalLen())
+
+	offset := 0
+	if ie := u.Cause; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.Recovery; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.TEIDCPlane; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.GGSNAddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range u.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(u.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	u.Header.SetLength()
+	return u.Header.MarshalTo(b)
+}
+
+// ParseUpdateMBMSContextResponse decodes a given byte sequence as a UpdateMBMSContextResponse.
+func ParseUpdateMBMSContextResponse(b []byte) (*UpdateMBMSContextResponse, error) {
+	u := &UpdateMBMSContextResponse{}
+	if err := u.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// UnmarshalBinary decodes a given byte sequence as a UpdateMBMSContextResponse.
+func (u *UpdateMBMSContextResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	u.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(u.Header.Payload) < 2 {
+		return nil
+	}
+
+	ies, err := ie.ParseMultiIEs(u.Header.Payload)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			u.Cause = i
+		case ie.Recovery:
+			u.Recovery = i
+		case ie.TEIDCPlane:
+			u.TEIDCPlane = i
+		case ie.GSNAddress:
+			u.GGSNAddressForControlPlane = i
+		case ie.PrivateExtension:
+			u.PrivateExtension = i
+		default:
+			u.AdditionalIEs = append(u.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of Data.
+func (u *UpdateMBMSContextResponse) MarshalLen() int {
+	l := u.Header.MarshalLen() - len(u.Header.Payload)
+
+	if ie := u.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.Recovery; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.TEIDCPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.GGSNAddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range u.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (u *UpdateMBMSContextResponse) SetLength() {
+	u.Length = uint16(u.MarshalLen() - 8)
+}
+
+// MessageTypeName returns the name of protocol.
+func (u *UpdateMBMSContextResponse) MessageTypeName() string {
+	return "Update MBMS Context Response"
+}
+
+// TEID returns the TEID in human-readable string.
+func (u *UpdateMBMSContextResponse) TEID() uint32 {
+	return u.Header.TEID
+}
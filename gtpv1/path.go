@@ -0,0 +1,308 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+)
+
+// DefaultN3Requests is the default number of Echo Requests that can be left
+// unanswered before the path is considered down.
+const DefaultN3Requests = 3
+
+// PathEventType is the type of PathEvent.
+type PathEventType uint8
+
+// PathEventType definitions.
+const (
+	_ PathEventType = iota
+	PathEventUp
+	PathEventDown
+	PathEventPeerRestarted
+)
+
+// String returns the name of PathEventType.
+func (t PathEventType) String() string {
+	switch t {
+	case PathEventUp:
+		return "Path Up"
+	case PathEventDown:
+		return "Path Down"
+	case PathEventPeerRestarted:
+		return "Peer Restarted"
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(t))
+	}
+}
+
+// PathEvent is an event on the path between Conn and a peer.
+type PathEvent struct {
+	Type PathEventType
+	Peer net.Addr
+
+	// RestartCounter is the latest Restart Counter advertised by the peer.
+	// This is valid only if HasRestartCounter is true.
+	RestartCounter    uint8
+	HasRestartCounter bool
+}
+
+// PathEventHandlerFunc is a handler for PathEvent.
+//
+// This is typically used to tear down the tunnels associated with the peer
+// when the path fails or the peer restarts.
+type PathEventHandlerFunc func(c Conn, ev *PathEvent)
+
+// path is the state of the path between Conn and a peer.
+type path struct {
+	mu                sync.Mutex
+	addr              net.Addr
+	isUp              bool
+	unanswered        int
+	restartCounter    uint8
+	hasRestartCounter bool
+}
+
+type pathMap struct {
+	syncMap sync.Map
+
+	// echoing holds the peers that Echo Request has been sent to and not yet
+	// answered, so that only the Echo Response to our own request adds the peer.
+	echoing sync.Map
+}
+
+func newPathMap() *pathMap {
+	return &pathMap{}
+}
+
+func (m *pathMap) learn(peer net.Addr) *path {
+	if v, ok := m.syncMap.Load(peer.String()); ok {
+		return v.(*path)
+	}
+	v, _ := m.syncMap.LoadOrStore(peer.String(), &path{addr: peer, isUp: true})
+	return v.(*path)
+}
+
+func (m *pathMap) forget(peer net.Addr) {
+	m.syncMap.Delete(peer.String())
+	m.echoing.Delete(peer.String())
+}
+
+func (m *pathMap) load(peer net.Addr) (*path, bool) {
+	v, ok := m.syncMap.Load(peer.String())
+	if !ok {
+		return nil, false
+	}
+	return v.(*path), true
+}
+
+func (m *pathMap) echoSent(peer net.Addr) {
+	m.echoing.Store(peer.String(), struct{}{})
+}
+
+func (m *pathMap) echoAnswered(peer net.Addr) bool {
+	_, ok := m.echoing.LoadAndDelete(peer.String())
+	return ok
+}
+
+func (m *pathMap) rangeWithFunc(fn func(peer, p interface{}) bool) {
+	m.syncMap.Range(fn)
+}
+
+// SetRestartCounter sets the Restart Counter advertised in Recovery IE by UPlaneConn.
+func (u *UPlaneConn) SetRestartCounter(counter uint8) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.restartCounter = counter
+}
+
+// SetN3Requests sets the number of Echo Requests that can be left unanswered
+// before the path is considered down. The default value is DefaultN3Requests.
+func (u *UPlaneConn) SetN3Requests(n3 int) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.n3Requests = n3
+}
+
+// SetPathEventHandler sets the handler called when the state of the path to any
+// of the peers changes. See StartPathManagement for how the state is managed.
+func (u *UPlaneConn) SetPathEventHandler(fn PathEventHandlerFunc) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.pathEventHandler = fn
+}
+
+// StartPathManagement starts sending Echo Request every interval to the peers
+// known to UPlaneConn, i.e., the ones added with AddPeer, dialled with DialUPlane,
+// or the ones that have answered Echo Request sent by UPlaneConn.
+//
+// A path is considered down when N3-REQUESTS Echo Requests in a row are left
+// unanswered, and considered up again when any message other than T-PDU comes
+// from the peer.
+// The Restart Counter in Recovery IE received from the peer is compared with the
+// previous one to detect the restart of the peer. Each change is notified to the
+// handler set with SetPathEventHandler.
+//
+// It stops when ctx is done or UPlaneConn is closed.
+func (u *UPlaneConn) StartPathManagement(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-u.closed():
+				return
+			case <-ticker.C:
+			}
+
+			u.pathMap.rangeWithFunc(func(k, v interface{}) bool {
+				u.checkPath(v.(*path))
+				return true
+			})
+		}
+	}()
+}
+
+// AddPeer adds the peer to the targets of the path management. Peers are added
+// automatically only when they are dialled or answer Echo Request sent by
+// UPlaneConn, so this is needed to watch the peers that UPlaneConn sends T-PDUs to.
+func (u *UPlaneConn) AddPeer(peer net.Addr) {
+	u.pathMap.learn(peer)
+}
+
+// RemovePeer removes the peer from the targets of the path management, e.g.,
+// when all the tunnels with the peer are deleted.
+func (u *UPlaneConn) RemovePeer(peer net.Addr) {
+	u.pathMap.forget(peer)
+}
+
+// IsPathUp reports whether the path to the peer is considered up.
+func (u *UPlaneConn) IsPathUp(peer net.Addr) bool {
+	p, ok := u.pathMap.load(peer)
+	if !ok {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.isUp
+}
+
+// PeerRestartCounter returns the latest Restart Counter advertised by the peer.
+// It returns false if no Restart Counter has been received from the peer.
+func (u *UPlaneConn) PeerRestartCounter(peer net.Addr) (uint8, bool) {
+	p, ok := u.pathMap.load(peer)
+	if !ok {
+		return 0, false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.restartCounter, p.hasRestartCounter
+}
+
+func (u *UPlaneConn) checkPath(p *path) {
+	u.mu.Lock()
+	n3 := u.n3Requests
+	u.mu.Unlock()
+
+	p.mu.Lock()
+	p.unanswered++
+	down := p.isUp && p.unanswered > n3
+	if down {
+		p.isUp = false
+	}
+	ev := &PathEvent{
+		Type:              PathEventDown,
+		Peer:              p.addr,
+		RestartCounter:    p.restartCounter,
+		HasRestartCounter: p.hasRestartCounter,
+	}
+	p.mu.Unlock()
+
+	if down {
+		u.notifyPathEvent(ev)
+	}
+
+	// keep sending Echo Request even if the path is down to know when it recovers.
+	if err := u.EchoRequest(p.addr); err != nil {
+		logf("failed to send Echo Request to %s: %v", p.addr, err)
+	}
+}
+
+// pathAlive marks the path to the peer up, as a message is received from it.
+// It does nothing if the peer is not the target of the path management.
+func (u *UPlaneConn) pathAlive(peer net.Addr) {
+	p, ok := u.pathMap.load(peer)
+	if !ok {
+		return
+	}
+
+	p.mu.Lock()
+	p.unanswered = 0
+	up := !p.isUp
+	p.isUp = true
+	ev := &PathEvent{
+		Type:              PathEventUp,
+		Peer:              p.addr,
+		RestartCounter:    p.restartCounter,
+		HasRestartCounter: p.hasRestartCounter,
+	}
+	p.mu.Unlock()
+
+	if up {
+		u.notifyPathEvent(ev)
+	}
+}
+
+// handleRecovery updates the Restart Counter of the peer with the value in Recovery IE
+// and checks if the peer has restarted.
+// It does nothing if the peer is not the target of the path management.
+func (u *UPlaneConn) handleRecovery(peer net.Addr, recovery *ie.IE) {
+	p, ok := u.pathMap.load(peer)
+	if !ok || recovery == nil {
+		return
+	}
+	counter, err := recovery.Recovery()
+	if err != nil {
+		logf("invalid Recovery IE from %s: %v", peer, err)
+		return
+	}
+
+	p.mu.Lock()
+	restarted := p.hasRestartCounter && p.restartCounter != counter
+	p.restartCounter = counter
+	p.hasRestartCounter = true
+	p.mu.Unlock()
+
+	if restarted {
+		u.notifyPathEvent(&PathEvent{
+			Type:              PathEventPeerRestarted,
+			Peer:              peer,
+			RestartCounter:    counter,
+			HasRestartCounter: true,
+		})
+	}
+}
+
+func (u *UPlaneConn) notifyPathEvent(ev *PathEvent) {
+	u.mu.Lock()
+	fn := u.pathEventHandler
+	u.mu.Unlock()
+
+	if fn == nil {
+		logf("%s: %s", ev.Type, ev.Peer)
+		return
+	}
+	fn(u, ev)
+}
@@ -0,0 +1,349 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv1
+
+import (
+	"net"
+	"sync"
+
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+)
+
+// PDPContext is a PDP Context handled by CPlaneConn.
+//
+// A PDPContext is identified by IMSI and NSAPI on the MS side, and by the TEID-C
+// allocated by each GSN on the Gn/Gp interface.
+type PDPContext struct {
+	mu             sync.Mutex
+	peerAddr       net.Addr
+	peerAddrString string
+
+	IMSI, MSISDN string
+	NSAPI        uint8
+	// LinkedNSAPI is the NSAPI of the primary PDP Context, which is set only if
+	// the PDPContext is a secondary PDP Context.
+	LinkedNSAPI uint8
+
+	APN string
+	// PDPTypeOrganization and PDPTypeNumber are the PDP Type in End User Address.
+	PDPTypeOrganization, PDPTypeNumber uint8
+	// MSAddressV4 and MSAddressV6 are the PDP Addresses in End User Address.
+	// These are nil if the address is not allocated yet (e.g., dynamic address
+	// in Create PDP Context Request).
+	MSAddressV4, MSAddressV6 net.IP
+	// QoSProfile is the value of QoS Profile IE.
+	QoSProfile []byte
+	ChargingID uint32
+
+	// LocalTEIDC is the TEID-C allocated by this node, which the peer sets in the
+	// GTPv1-C header of the messages sent for this PDPContext.
+	LocalTEIDC uint32
+	// RemoteTEIDC is the TEID-C allocated by the peer, which is set in the GTPv1-C
+	// header of the messages sent to the peer for this PDPContext.
+	RemoteTEIDC uint32
+	// LocalTEIDU and RemoteTEIDU are the TEIDs for the user traffic allocated by
+	// this node and the peer respectively.
+	LocalTEIDU, RemoteTEIDU uint32
+	// RemoteUPlaneAddress is the GSN Address for user traffic of the peer.
+	RemoteUPlaneAddress net.IP
+}
+
+// NewPDPContext creates a new PDPContext with the peer's address, IMSI and NSAPI.
+func NewPDPContext(peerAddr net.Addr, imsi string, nsapi uint8) *PDPContext {
+	p := &PDPContext{
+		mu:    sync.Mutex{},
+		IMSI:  imsi,
+		NSAPI: nsapi,
+	}
+	p.SetPeerAddr(peerAddr)
+
+	return p
+}
+
+// NewPDPContextFromRequest creates a new PDPContext from the CreatePDPContextRequest
+// received from the peer, typically on GGSN.
+//
+// The values in IEs are stored with "best effort"; TEID Data I, TEID C-Plane and
+// SGSN Address for user traffic are stored as the ones of the peer. LocalTEIDC and
+// LocalTEIDU should be set by the caller, and CPlaneConn.RegisterPDPContext should
+// be called to look up the PDPContext with TEID-C later.
+//
+// As IMSI is not included in the request for secondary PDP Context, use
+// CPlaneConn.ParseCreatePDPContextRequest instead to get IMSI from the primary one.
+func NewPDPContextFromRequest(peerAddr net.Addr, req *message.CreatePDPContextRequest) (*PDPContext, error) {
+	p := NewPDPContext(peerAddr, "", 0)
+	if err := p.fillFromRequest(req, false); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// NewPDPContextFromResponse creates a new PDPContext from the CreatePDPContextResponse
+// received from the peer, typically on SGSN.
+//
+// As the response does not contain IMSI and NSAPI, it is in most cases better to
+// use UpdateFromResponse with the PDPContext created when sending the request.
+func NewPDPContextFromResponse(peerAddr net.Addr, res *message.CreatePDPContextResponse) (*PDPContext, error) {
+	p := NewPDPContext(peerAddr, "", 0)
+	if err := p.UpdateFromResponse(res); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// UpdateFromResponse updates PDPContext with the values in CreatePDPContextResponse
+// received from the peer.
+//
+// TEID Data I, TEID C-Plane and GGSN Address for user traffic are stored as the
+// ones of the peer. The End User Address is updated only if it is included, as
+// GGSN may allocate the address dynamically.
+func (p *PDPContext) UpdateFromResponse(res *message.CreatePDPContextResponse) error {
+	var err error
+	if i := res.NSAPI; i != nil && p.NSAPI == 0 {
+		p.NSAPI, err = i.NSAPI()
+		if err != nil {
+			return err
+		}
+	}
+	if i := res.TEIDCPlane; i != nil {
+		p.RemoteTEIDC, err = i.TEID()
+		if err != nil {
+			return err
+		}
+	}
+	if i := res.TEIDDataI; i != nil {
+		p.RemoteTEIDU, err = i.TEID()
+		if err != nil {
+			return err
+		}
+	}
+	if i := res.EndUserAddress; i != nil {
+		if err := p.setEndUserAddress(i); err != nil {
+			return err
+		}
+	}
+	if i := res.QoSProfile; i != nil {
+		p.QoSProfile, err = i.QoSProfile()
+		if err != nil {
+			return err
+		}
+	}
+	if i := res.ChargingID; i != nil {
+		p.ChargingID, err = i.ChargingID()
+		if err != nil {
+			return err
+		}
+	}
+	if i := res.GGSNAddressForUserTraffic; i != nil {
+		p.RemoteUPlaneAddress, err = i.IP()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fillFromRequest fills PDPContext with the values in CreatePDPContextRequest.
+// If local is true, the TEIDs in the request are considered as the ones allocated
+// by this node, i.e., the request is the one to be sent.
+func (p *PDPContext) fillFromRequest(req *message.CreatePDPContextRequest, local bool) error {
+	var err error
+	if i := req.IMSI; i != nil {
+		p.IMSI, err = i.IMSI()
+		if err != nil {
+			return err
+		}
+	}
+	if i := req.MSISDN; i != nil {
+		p.MSISDN, err = i.MSISDN()
+		if err != nil {
+			return err
+		}
+	}
+	if i := req.NSAPI; i != nil {
+		p.NSAPI, err = i.NSAPI()
+		if err != nil {
+			return err
+		}
+	}
+	if i := req.LinkedNSAPI; i != nil {
+		p.LinkedNSAPI, err = i.NSAPI()
+		if err != nil {
+			return err
+		}
+	}
+	if i := req.APN; i != nil {
+		p.APN, err = i.AccessPointName()
+		if err != nil {
+			return err
+		}
+	}
+	if i := req.EndUserAddress; i != nil {
+		if err := p.setEndUserAddress(i); err != nil {
+			return err
+		}
+	}
+	if i := req.QoSProfile; i != nil {
+		p.QoSProfile, err = i.QoSProfile()
+		if err != nil {
+			return err
+		}
+	}
+	if i := req.TEIDCPlane; i != nil {
+		teid, err := i.TEID()
+		if err != nil {
+			return err
+		}
+		if local {
+			p.LocalTEIDC = teid
+		} else {
+			p.RemoteTEIDC = teid
+		}
+	}
+	if i := req.TEIDDataI; i != nil {
+		teid, err := i.TEID()
+		if err != nil {
+			return err
+		}
+		if local {
+			p.LocalTEIDU = teid
+		} else {
+			p.RemoteTEIDU = teid
+		}
+	}
+	if i := req.SGSNAddressForUserTraffic; i != nil && !local {
+		p.RemoteUPlaneAddress, err = i.IP()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *PDPContext) setEndUserAddress(i *ie.IE) error {
+	var err error
+	p.PDPTypeOrganization, err = i.PDPTypeOrganization()
+	if err != nil {
+		return err
+	}
+	p.PDPTypeNumber, err = i.PDPTypeNumber()
+	if err != nil {
+		return err
+	}
+
+	// the PDP Address is empty if it is to be allocated dynamically.
+	addr := i.Payload[2:]
+	switch len(addr) {
+	case net.IPv4len:
+		p.MSAddressV4 = net.IP(addr)
+	case net.IPv6len:
+		p.MSAddressV6 = net.IP(addr)
+	case net.IPv4len + net.IPv6len:
+		p.MSAddressV4 = net.IP(addr[:net.IPv4len])
+		p.MSAddressV6 = net.IP(addr[net.IPv4len:])
+	}
+	return nil
+}
+
+// PeerAddr returns the address of the peer GSN.
+func (p *PDPContext) PeerAddr() net.Addr {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.peerAddr
+}
+
+// SetPeerAddr sets the address of the peer GSN.
+//
+// This is typically used when the PDPContext is moved to another GSN, e.g., by
+// the inter-SGSN Routeing Area Update.
+func (p *PDPContext) SetPeerAddr(peerAddr net.Addr) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.peerAddr = peerAddr
+	if peerAddr != nil {
+		p.peerAddrString = peerAddr.String()
+	}
+}
+
+// IsSecondary reports whether the PDPContext is a secondary PDP Context, which is
+// linked to the primary one with LinkedNSAPI.
+func (p *PDPContext) IsSecondary() bool {
+	return p.LinkedNSAPI != 0
+}
+
+// pdpContextKey is the key to identify a PDPContext from the MS side.
+type pdpContextKey struct {
+	imsi  string
+	nsapi uint8
+}
+
+type imsiNSAPIPDPContextMap struct {
+	syncMap sync.Map
+}
+
+func newimsiNSAPIPDPContextMap() *imsiNSAPIPDPContextMap {
+	return &imsiNSAPIPDPContextMap{}
+}
+
+func (m *imsiNSAPIPDPContextMap) store(imsi string, nsapi uint8, pdp *PDPContext) {
+	m.syncMap.Store(pdpContextKey{imsi, nsapi}, pdp)
+}
+
+func (m *imsiNSAPIPDPContextMap) load(imsi string, nsapi uint8) (*PDPContext, bool) {
+	pdp, ok := m.syncMap.Load(pdpContextKey{imsi, nsapi})
+	if ok && pdp != nil {
+		return pdp.(*PDPContext), true
+	}
+	return nil, false
+}
+
+func (m *imsiNSAPIPDPContextMap) delete(imsi string, nsapi uint8) {
+	m.syncMap.Delete(pdpContextKey{imsi, nsapi})
+}
+
+func (m *imsiNSAPIPDPContextMap) rangeWithFunc(fn func(key, pdp interface{}) bool) {
+	m.syncMap.Range(fn)
+}
+
+type teidPDPContextMap struct {
+	syncMap sync.Map
+}
+
+func newteidPDPContextMap() *teidPDPContextMap {
+	return &teidPDPContextMap{}
+}
+
+func (t *teidPDPContextMap) store(teid uint32, pdp *PDPContext) {
+	t.syncMap.Store(teid, pdp)
+}
+
+func (t *teidPDPContextMap) tryStore(teid uint32, pdp *PDPContext) bool {
+	_, loaded := t.syncMap.LoadOrStore(teid, pdp)
+	return !loaded
+}
+
+func (t *teidPDPContextMap) load(teid uint32) (*PDPContext, bool) {
+	v, ok := t.syncMap.Load(teid)
+	if !ok {
+		return nil, false
+	}
+
+	// the value is nil if the TEID is just reserved by NewTEIDCPlane.
+	pdp := v.(*PDPContext)
+	return pdp, pdp != nil
+}
+
+func (t *teidPDPContextMap) delete(teid uint32) {
+	t.syncMap.Delete(teid)
+}
+
+func (t *teidPDPContextMap) rangeWithFunc(fn func(teid, pdp interface{}) bool) {
+	t.syncMap.Range(fn)
+}
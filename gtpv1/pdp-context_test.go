@@ -0,0 +1,81 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv1_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/wmnsk/go-gtp/gtpv1"
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+)
+
+func TestPDPContext(t *testing.T) {
+	peer := &net.UDPAddr{IP: net.IP{127, 0, 0, 1}, Port: 2123}
+	qos := []byte{0x01, 0x23, 0x92, 0x1f, 0x91, 0x97, 0xfe, 0xfe, 0x74, 0xf9, 0xff, 0xff}
+
+	req := message.NewCreatePDPContextRequest(
+		0, 0,
+		ie.NewIMSI("123451234567890"),
+		ie.NewTEIDDataI(0x11111111),
+		ie.NewTEIDCPlane(0x22222222),
+		ie.NewNSAPI(6),
+		ie.NewNSAPI(5),
+		ie.NewEndUserAddressIPv4(""),
+		ie.NewAccessPointName("some.apn.example"),
+		ie.NewGSNAddress("10.0.0.1"),
+		ie.NewGSNAddress("10.0.0.2"),
+		ie.NewMSISDN("819012345678"),
+		ie.NewQoSProfile(qos),
+	)
+
+	pdp, err := gtpv1.NewPDPContextFromRequest(peer, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pdp.IsSecondary() {
+		t.Error("PDPContext with Linked NSAPI is not considered as secondary")
+	}
+
+	res := message.NewCreatePDPContextResponse(
+		0x22222222, 0,
+		ie.NewCause(gtpv1.ResCauseRequestAccepted),
+		ie.NewTEIDDataI(0x33333333),
+		ie.NewTEIDCPlane(0x44444444),
+		ie.NewChargingID(0xffffffff),
+		ie.NewEndUserAddressIPv4("192.168.0.1"),
+		ie.NewGSNAddress("10.0.0.3"),
+		ie.NewGSNAddress("10.0.0.4"),
+	)
+	if err := pdp.UpdateFromResponse(res); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &gtpv1.PDPContext{
+		IMSI:                "123451234567890",
+		MSISDN:              "819012345678",
+		NSAPI:               6,
+		LinkedNSAPI:         5,
+		APN:                 "some.apn.example",
+		PDPTypeOrganization: gtpv1.PDPTypeIETF,
+		PDPTypeNumber:       gtpv1.PDPTypeIPv4,
+		MSAddressV4:         net.IP{192, 168, 0, 1},
+		QoSProfile:          qos,
+		ChargingID:          0xffffffff,
+		RemoteTEIDC:         0x44444444,
+		RemoteTEIDU:         0x33333333,
+		RemoteUPlaneAddress: net.IP{10, 0, 0, 4},
+	}
+	if diff := cmp.Diff(want, pdp, cmpopts.IgnoreUnexported(gtpv1.PDPContext{})); diff != "" {
+		t.Error(diff)
+	}
+	if got := pdp.PeerAddr(); got != peer {
+		t.Errorf("wrong peer address. want %s, got: %s", peer, got)
+	}
+}
@@ -28,6 +28,8 @@ type tpduSet struct {
 	teid    uint32
 	seq     uint16
 	payload []byte
+
+	extHeaders []*message.ExtensionHeader
 }
 
 // UPlaneConn represents a U-Plane Connection of GTPv1.
@@ -45,6 +47,16 @@ type UPlaneConn struct {
 
 	errIndEnabled bool
 
+	// restartCounter is the Restart Counter value in Recovery IE sent by UPlaneConn.
+	restartCounter uint8
+
+	// pathMap holds the state of the paths to the peers known to UPlaneConn.
+	*pathMap
+	n3Requests       int
+	pathEventHandler PathEventHandlerFunc
+
+	endMarkerHandler EndMarkerHandlerFunc
+
 	// for Linux kernel GTP with netlink
 	KernelGTP
 }
@@ -68,11 +80,23 @@ func NewUPlaneConn(laddr net.Addr) *UPlaneConn {
 		closeCh: make(chan struct{}),
 
 		errIndEnabled: true,
+
+		pathMap:    newPathMap(),
+		n3Requests: DefaultN3Requests,
 	}
 }
 
 // DialUPlane sends Echo Request to raddr to check if the endpoint is alive and returns UPlaneConn.
+//
+// The Restart Counter in Recovery IE is 0. Use DialUPlaneWithRestartCounter to advertise
+// the other value from the first Echo Request.
 func DialUPlane(ctx context.Context, laddr, raddr net.Addr) (*UPlaneConn, error) {
+	return DialUPlaneWithRestartCounter(ctx, laddr, raddr, 0)
+}
+
+// DialUPlaneWithRestartCounter works the same as DialUPlane, but the Restart Counter
+// in Recovery IE sent by UPlaneConn is set to counter.
+func DialUPlaneWithRestartCounter(ctx context.Context, laddr, raddr net.Addr, counter uint8) (*UPlaneConn, error) {
 	u := &UPlaneConn{
 		mu:            sync.Mutex{},
 		msgHandlerMap: newDefaultMsgHandlerMap(),
@@ -82,7 +106,11 @@ func DialUPlane(ctx context.Context, laddr, raddr net.Addr) (*UPlaneConn, error)
 		tpduCh:  make(chan *tpduSet),
 		closeCh: make(chan struct{}),
 
-		errIndEnabled: true,
+		errIndEnabled:  true,
+		restartCounter: counter,
+
+		pathMap:    newPathMap(),
+		n3Requests: DefaultN3Requests,
 	}
 
 	// setup UDPConn first.
@@ -113,7 +141,7 @@ func DialUPlane(ctx context.Context, laddr, raddr net.Addr) (*UPlaneConn, error)
 			return nil, err
 		}
 
-		n, _, err := u.pktConn.ReadFrom(buf)
+		n, raddr, err := u.pktConn.ReadFrom(buf)
 		if err != nil {
 			return nil, err
 		}
@@ -126,9 +154,12 @@ func DialUPlane(ctx context.Context, laddr, raddr net.Addr) (*UPlaneConn, error)
 		if err != nil {
 			return nil, err
 		}
-		if _, ok := msg.(*message.EchoResponse); !ok {
+		echo, ok := msg.(*message.EchoResponse)
+		if !ok {
 			continue
 		}
+		u.AddPeer(raddr)
+		u.handleRecovery(raddr, echo.Recovery)
 
 		break
 	}
@@ -298,6 +329,27 @@ func (u *UPlaneConn) ReadFromGTP(p []byte) (n int, addr net.Addr, teid uint32, e
 	}
 }
 
+// ReadFromGTPWithExtensionHeaders works the same as ReadFromGTP, but it also returns
+// the Extension Headers in the GTP header, e.g., PDU Session Container on N3/N9.
+//
+// Note that valid GTP-U packets handled by Kernel can NOT be retrieved by this.
+func (u *UPlaneConn) ReadFromGTPWithExtensionHeaders(p []byte) (n int, addr net.Addr, teid uint32, ehs []*message.ExtensionHeader, err error) {
+	select {
+	case <-u.closed():
+		return
+	case tpdu, ok := <-u.tpduCh:
+		if !ok {
+			err = ErrConnNotOpened
+			return
+		}
+		n = copy(p, tpdu.payload)
+		addr = tpdu.raddr
+		teid = tpdu.teid
+		ehs = tpdu.extHeaders
+		return
+	}
+}
+
 // WriteTo writes a packet with payload p to addr.
 // WriteTo can be made to time out and return
 // an Error with Timeout() == true after a fixed time limit;
@@ -320,6 +372,19 @@ func (u *UPlaneConn) WriteToGTP(teid uint32, p []byte, addr net.Addr) (n int, er
 	return len(b), nil
 }
 
+// WriteToGTPWithExtensionHeaders writes a packet with TEID, Extension Headers and payload to addr.
+func (u *UPlaneConn) WriteToGTPWithExtensionHeaders(teid uint32, p []byte, addr net.Addr, ehs ...*message.ExtensionHeader) (n int, err error) {
+	b, err := EncapsulateWithExtensionHeaders(teid, p, ehs...).Marshal()
+	if err != nil {
+		return
+	}
+
+	if _, err = u.pktConn.WriteTo(b, addr); err != nil {
+		return
+	}
+	return len(b), nil
+}
+
 // closed would be used in multiple goroutines.
 // never send struct{}{} to it; instead, use close(u.closeCh).
 func (u *UPlaneConn) closed() <-chan struct{} {
@@ -388,9 +453,9 @@ func (u *UPlaneConn) SetWriteDeadline(t time.Time) error {
 // any values, which is in most cases vital to continue working as a node, from the incoming
 // message.
 //
-// HandlerFuncs for EchoResponse and ErrorIndication are registered by default.
-// These HandlerFuncs can be overwritten by specifying message.MsgTypeEchoResponse and/or
-// message.MsgTypeErrorIndication as msgType parameter.
+// HandlerFuncs for EchoResponse, ErrorIndication and EndMarker are registered by default.
+// These HandlerFuncs can be overwritten by specifying message.MsgTypeEchoResponse,
+// message.MsgTypeErrorIndication and/or message.MsgTypeEndMarker as msgType parameter.
 func (u *UPlaneConn) AddHandler(msgType uint8, fn HandlerFunc) {
 	u.msgHandlerMap.store(msgType, fn)
 }
@@ -405,6 +470,12 @@ func (u *UPlaneConn) AddHandlers(funcs map[uint8]HandlerFunc) {
 }
 
 func (u *UPlaneConn) handleMessage(senderAddr net.Addr, msg message.Message) error {
+	// any message from the peer means the path is alive. T-PDU is excluded
+	// not to look up the path for every packet on the data plane.
+	if msg.MessageType() != message.MsgTypeTPDU {
+		u.pathAlive(senderAddr)
+	}
+
 	handle, ok := u.msgHandlerMap.load(msg.MessageType())
 	if !ok {
 		return &HandlerNotFoundError{MsgType: msg.MessageTypeName()}
@@ -419,7 +490,7 @@ func (u *UPlaneConn) handleMessage(senderAddr net.Addr, msg message.Message) err
 
 // EchoRequest sends a EchoRequest.
 func (u *UPlaneConn) EchoRequest(raddr net.Addr) error {
-	b, err := message.NewEchoRequest(0, ie.NewRecovery(0)).Marshal()
+	b, err := message.NewEchoRequest(0, ie.NewRecovery(u.Restarts())).Marshal()
 	if err != nil {
 		return err
 	}
@@ -427,12 +498,13 @@ func (u *UPlaneConn) EchoRequest(raddr net.Addr) error {
 	if _, err := u.pktConn.WriteTo(b, raddr); err != nil {
 		return err
 	}
+	u.pathMap.echoSent(raddr)
 	return nil
 }
 
 // EchoResponse sends a EchoResponse.
 func (u *UPlaneConn) EchoResponse(raddr net.Addr) error {
-	b, err := message.NewEchoResponse(0, ie.NewRecovery(0)).Marshal()
+	b, err := message.NewEchoResponse(0, ie.NewRecovery(u.Restarts())).Marshal()
 	if err != nil {
 		return err
 	}
@@ -465,6 +537,36 @@ func (u *UPlaneConn) ErrorIndication(raddr net.Addr, received message.Message) e
 	return nil
 }
 
+// EndMarker sends an End Marker with the TEID given to raddr.
+//
+// This is typically used by S-GW or UPF to indicate the end of the payload on the
+// old path after the downlink path is switched.
+func (u *UPlaneConn) EndMarker(teid uint32, raddr net.Addr) error {
+	b, err := message.NewEndMarker(teid).Marshal()
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.WriteTo(b, raddr); err != nil {
+		return err
+	}
+	return nil
+}
+
+// EndMarkerHandlerFunc is a handler called when an End Marker is received.
+type EndMarkerHandlerFunc func(c Conn, senderAddr net.Addr, teid uint32)
+
+// SetEndMarkerHandler sets the handler called when an End Marker is received.
+//
+// End Markers are just logged and ignored unless the handler is set. This is
+// done by the default HandlerFunc for End Marker, and thus the handler is not
+// called if it is overwritten with AddHandler.
+func (u *UPlaneConn) SetEndMarkerHandler(fn EndMarkerHandlerFunc) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.endMarkerHandler = fn
+}
+
 // RespondTo sends a message(specified with "toBeSent" param) in response to
 // a message(specified with "received" param).
 //
@@ -483,8 +585,12 @@ func (u *UPlaneConn) RespondTo(raddr net.Addr, received, toBeSent message.Messag
 }
 
 // Restarts returns the number of restarts in uint8.
+//
+// The value is 0 unless it is set with SetRestartCounter or DialUPlaneWithRestartCounter.
 func (u *UPlaneConn) Restarts() uint8 {
-	return 0
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.restartCounter
 }
 
 // NewFTEID creates a new GTPv2 F-TEID with random TEID value that is unique within UPlaneConn.
@@ -13,6 +13,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/wmnsk/go-gtp/gtpv1"
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
 )
 
 type testVal struct {
@@ -22,11 +24,15 @@ type testVal struct {
 }
 
 func setup(ctx context.Context) (cliConn, srvConn *gtpv1.UPlaneConn, err error) {
-	cliAddr, err := net.ResolveUDPAddr("udp", "127.0.0.1:2152")
+	return setupWithAddrs(ctx, "127.0.0.1:2152", "127.0.0.2:2152")
+}
+
+func setupWithAddrs(ctx context.Context, cli, srv string) (cliConn, srvConn *gtpv1.UPlaneConn, err error) {
+	cliAddr, err := net.ResolveUDPAddr("udp", cli)
 	if err != nil {
 		return nil, nil, err
 	}
-	srvAddr, err := net.ResolveUDPAddr("udp", "127.0.0.2:2152")
+	srvAddr, err := net.ResolveUDPAddr("udp", srv)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -103,3 +109,228 @@ func TestClientWrite(t *testing.T) {
 		t.Fatal("timed out while waiting for response to come")
 	}
 }
+
+func TestClientWriteWithExtensionHeaders(t *testing.T) {
+	var (
+		okCh  = make(chan struct{})
+		errCh = make(chan error)
+		buf   = make([]byte, 2048)
+		tv    = &testVal{
+			0x11111111, 0x22222222, 0x3333,
+			[]byte{0xde, 0xad, 0xbe, 0xef},
+		}
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cliConn, srvConn, err := setupWithAddrs(ctx, "127.0.0.7:2152", "127.0.0.8:2152")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ehs := []*message.ExtensionHeader{
+		message.NewPDUSessionContainerExtensionHeader(message.PDUTypeULPDUSessionInformation, 5, false),
+	}
+	go func(tv *testVal) {
+		n, _, teid, got, err := srvConn.ReadFromGTPWithExtensionHeaders(buf)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		if diff := cmp.Diff(teid, tv.teidOut); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(buf[:n], tv.payload); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(got, ehs); diff != "" {
+			t.Error(diff)
+		}
+		okCh <- struct{}{}
+	}(tv)
+
+	if _, err := cliConn.WriteToGTPWithExtensionHeaders(tv.teidOut, tv.payload, srvConn.LocalAddr(), ehs...); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-okCh:
+		return
+	case err := <-errCh:
+		t.Fatal(err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out while waiting for response to come")
+	}
+}
+
+func TestEndMarker(t *testing.T) {
+	var teid uint32 = 0x22222222
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cliConn, srvConn, err := setupWithAddrs(ctx, "127.0.0.9:2152", "127.0.0.10:2152")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// End Marker should be notified to the handler with the TEID.
+	emCh := make(chan uint32)
+	srvConn.SetEndMarkerHandler(func(c gtpv1.Conn, senderAddr net.Addr, teid uint32) {
+		emCh <- teid
+	})
+	if err := cliConn.EndMarker(teid, srvConn.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case got := <-emCh:
+		if diff := cmp.Diff(got, teid); diff != "" {
+			t.Error(diff)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out while waiting for End Marker to come")
+	}
+}
+
+func TestPathManagement(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer, err := net.ListenPacket("udp", "127.0.0.3:2152")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer peer.Close()
+
+	// respond to Echo Request twice with different Restart Counter, and then
+	// stop responding to make the path down.
+	go func() {
+		buf := make([]byte, 1500)
+		for counter := uint8(1); counter <= 2; counter++ {
+			n, raddr, err := peer.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			req, err := message.Parse(buf[:n])
+			if err != nil {
+				return
+			}
+			if echo, ok := req.(*message.EchoRequest); ok && counter == 1 {
+				if len(echo.AdditionalIEs) != 1 || echo.AdditionalIEs[0].MustRecovery() != 5 {
+					t.Errorf("wrong Recovery in Echo Request: %v", echo.AdditionalIEs)
+				}
+			}
+			res, err := message.NewEchoResponse(req.Sequence(), ie.NewRecovery(counter)).Marshal()
+			if err != nil {
+				return
+			}
+			if _, err := peer.WriteTo(res, raddr); err != nil {
+				return
+			}
+		}
+	}()
+
+	cliAddr, err := net.ResolveUDPAddr("udp", "127.0.0.4:2152")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cliConn, err := gtpv1.DialUPlaneWithRestartCounter(ctx, cliAddr, peer.LocalAddr(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counter, ok := cliConn.PeerRestartCounter(peer.LocalAddr()); !ok || counter != 1 {
+		t.Errorf("wrong PeerRestartCounter. want %d, got: %d", 1, counter)
+	}
+
+	evCh := make(chan *gtpv1.PathEvent, 10)
+	cliConn.SetPathEventHandler(func(c gtpv1.Conn, ev *gtpv1.PathEvent) {
+		evCh <- ev
+	})
+	cliConn.SetN3Requests(1)
+	cliConn.StartPathManagement(ctx, 50*time.Millisecond)
+
+	for _, want := range []gtpv1.PathEventType{gtpv1.PathEventPeerRestarted, gtpv1.PathEventDown} {
+		select {
+		case ev := <-evCh:
+			if ev.Type != want {
+				t.Fatalf("unexpected event. want: %s, got: %s", want, ev.Type)
+			}
+			if ev.Peer.String() != peer.LocalAddr().String() {
+				t.Errorf("unexpected peer in event: %s", ev.Peer)
+			}
+			if ev.RestartCounter != 2 {
+				t.Errorf("wrong RestartCounter. want %d, got: %d", 2, ev.RestartCounter)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out while waiting for %s", want)
+		}
+	}
+
+	if cliConn.IsPathUp(peer.LocalAddr()) {
+		t.Error("path is unexpectedly up")
+	}
+
+	// the restart of the peer should also be detected with Echo Request from it.
+	req, err := message.NewEchoRequest(0, ie.NewRecovery(3)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := peer.WriteTo(req, cliAddr); err != nil {
+		t.Fatal(err)
+	}
+	for restarted := false; !restarted; {
+		select {
+		case ev := <-evCh:
+			if ev.Type != gtpv1.PathEventPeerRestarted {
+				continue
+			}
+			if ev.RestartCounter != 3 {
+				t.Errorf("wrong RestartCounter. want %d, got: %d", 3, ev.RestartCounter)
+			}
+			restarted = true
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out while waiting for %s", gtpv1.PathEventPeerRestarted)
+		}
+	}
+
+	cliConn.RemovePeer(peer.LocalAddr())
+	if _, ok := cliConn.PeerRestartCounter(peer.LocalAddr()); ok {
+		t.Error("peer is unexpectedly left after RemovePeer")
+	}
+}
+
+func TestUnsolicitedPeer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the client sends Echo Request to the server in DialUPlane.
+	cliConn, srvConn, err := setupWithAddrs(ctx, "127.0.0.13:2152", "127.0.0.14:2152")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the server should not watch the peer only with the Echo Request from it.
+	if _, ok := srvConn.PeerRestartCounter(cliConn.LocalAddr()); ok {
+		t.Error("peer is unexpectedly added by unsolicited Echo Request")
+	}
+	if srvConn.IsPathUp(cliConn.LocalAddr()) {
+		t.Error("path is unexpectedly up for unsolicited Echo Request")
+	}
+
+	// the peer is added once it answers Echo Request from the server.
+	if err := srvConn.EchoRequest(cliConn.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+	timeout := time.After(2 * time.Second)
+	for {
+		if _, ok := srvConn.PeerRestartCounter(cliConn.LocalAddr()); ok {
+			break
+		}
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-timeout:
+			t.Fatal("peer is not added by Echo Response")
+		}
+	}
+}
@@ -12,6 +12,12 @@ func Encapsulate(teid uint32, payload []byte) *message.TPDU {
 	return pdu
 }
 
+// EncapsulateWithExtensionHeaders encapsulates given bytes with GTPv1-U Header
+// containing the Extension Headers given, and returns in message.TPDU.
+func EncapsulateWithExtensionHeaders(teid uint32, payload []byte, ehs ...*message.ExtensionHeader) *message.TPDU {
+	return message.NewTPDUWithExtensionHeaders(teid, payload, ehs...)
+}
+
 // Decapsulate decapsulates given bytes and returns TEID, and Payload.
 func Decapsulate(b []byte) (uint32, []byte, error) {
 	header, err := message.ParseHeader(b)
@@ -36,11 +36,31 @@ type Conn struct {
 	*iteiSessionMap
 	localIfType uint8
 
+	// fwdTEIDSessionMap holds the TEIDs allocated for the indirect data forwarding
+	// tunnels, with the Session they belong to.
+	fwdTEIDSessionMap *iteiSessionMap
+
 	validationEnabled bool
 
 	closeCh chan struct{}
 	*msgHandlerMap
 
+	// transactionMap holds the initial messages waiting for the triggered messages.
+	// See TS29.274 7.6 for details.
+	*transactionMap
+	t3Response     time.Duration
+	n3Requests     int
+	timeoutHandler TimeoutHandlerFunc
+
+	// responseCache holds the triggered messages sent recently, to respond to the
+	// initial messages retransmitted by peers without handling them again.
+	*responseCache
+
+	// pathMap holds the state of the paths to the peers known to Conn.
+	*pathMap
+	pathEventHandler    PathEventHandlerFunc
+	sessionPurgeEnabled bool
+
 	// sequence is the last SequenceNumber used in the request.
 	//
 	// TS29.274 7.6  Reliable Delivery of Signalling Messages;
@@ -60,10 +80,16 @@ func NewConn(laddr net.Addr, localIfType, counter uint8) *Conn {
 		laddr:             laddr,
 		imsiSessionMap:    newimsiSessionMap(),
 		iteiSessionMap:    newiteiSessionMap(),
+		fwdTEIDSessionMap: newiteiSessionMap(),
 		localIfType:       localIfType,
 		validationEnabled: true,
 		closeCh:           make(chan struct{}),
 		msgHandlerMap:     newDefaultMsgHandlerMap(),
+		transactionMap:    newTransactionMap(),
+		responseCache:     newResponseCache(),
+		pathMap:           newPathMap(),
+		t3Response:        DefaultT3Response,
+		n3Requests:        DefaultN3Requests,
 		sequence:          0,
 		RestartCounter:    counter,
 	}
@@ -81,10 +107,16 @@ func Dial(ctx context.Context, laddr, raddr net.Addr, localIfType, counter uint8
 		laddr:             laddr,
 		imsiSessionMap:    newimsiSessionMap(),
 		iteiSessionMap:    newiteiSessionMap(),
+		fwdTEIDSessionMap: newiteiSessionMap(),
 		localIfType:       localIfType,
 		validationEnabled: true,
 		closeCh:           make(chan struct{}),
 		msgHandlerMap:     newDefaultMsgHandlerMap(),
+		transactionMap:    newTransactionMap(),
+		responseCache:     newResponseCache(),
+		pathMap:           newPathMap(),
+		t3Response:        DefaultT3Response,
+		n3Requests:        DefaultN3Requests,
 		sequence:          0,
 		RestartCounter:    counter,
 	}
@@ -99,7 +131,17 @@ func Dial(ctx context.Context, laddr, raddr net.Addr, localIfType, counter uint8
 	}
 
 	// send EchoRequest to raddr.
-	if _, err := c.EchoRequest(raddr); err != nil {
+	tx, err := c.sendMessageTo(message.NewEchoRequest(0, ie.NewRecovery(c.RestartCounter)), raddr, false)
+	if err != nil {
+		_ = c.pktConn.Close()
+		return nil, err
+	}
+
+	// stop retransmitting the EchoRequest and release the underlying connection
+	// if Dial fails after this point.
+	fail := func(err error) (*Conn, error) {
+		c.cancelTransaction(tx)
+		_ = c.pktConn.Close()
 		return nil, err
 	}
 
@@ -107,23 +149,23 @@ func Dial(ctx context.Context, laddr, raddr net.Addr, localIfType, counter uint8
 
 	// if no response coming within 3 seconds, returns error without retrying.
 	if err := c.pktConn.SetReadDeadline(time.Now().Add(3 * time.Second)); err != nil {
-		return nil, err
+		return fail(err)
 	}
 	n, raddr, err := c.pktConn.ReadFrom(buf)
 	if err != nil {
-		return nil, err
+		return fail(err)
 	}
 	if err := c.pktConn.SetReadDeadline(time.Time{}); err != nil {
-		return nil, err
+		return fail(err)
 	}
 
 	// decode incoming message and let it be handled by default handler funcs.
 	msg, err := message.Parse(buf[:n])
 	if err != nil {
-		return nil, err
+		return fail(err)
 	}
 	if err := c.handleMessage(raddr, msg); err != nil {
-		return nil, err
+		return fail(err)
 	}
 
 	go func() {
@@ -314,24 +356,79 @@ func (c *Conn) AddHandlers(funcs map[uint8]HandlerFunc) {
 }
 
 func (c *Conn) handleMessage(senderAddr net.Addr, msg message.Message) error {
+	// stop retransmitting the initial message if it is the triggered message.
+	// if the initial message is sent with Request, the triggered message is returned
+	// to the caller as it is, without validation and HandlerFunc.
+	if msg.Version() == 2 {
+		// any message from the peer means the path is alive.
+		if p, ok := c.pathMap.load(senderAddr.String()); ok {
+			c.setPathState(p, true)
+		}
+
+		if tx := c.resolveTransaction(senderAddr, msg); tx != nil && tx.synchronous {
+			return nil
+		}
+	}
+
 	if c.validationEnabled {
 		if err := c.validate(senderAddr, msg); err != nil {
 			return fmt.Errorf("failed to validate %s: %w", msg.MessageTypeName(), err)
 		}
 	}
 
+	// respond to the retransmitted initial message with the cached response,
+	// instead of letting the handler handle it again.
+	dup, err := c.checkDuplicate(senderAddr, msg)
+	if err != nil {
+		return fmt.Errorf("failed to respond to duplicated %s: %w", msg.MessageTypeName(), err)
+	}
+	if dup {
+		return nil
+	}
+
+	// update the suspended state of the Session before HandlerFunc responds.
+	c.handleSuspension(senderAddr, msg)
+
 	handle, ok := c.msgHandlerMap.load(msg.MessageType())
 	if !ok {
+		c.forgetRequest(senderAddr, msg)
 		return &HandlerNotFoundError{MsgType: msg.MessageTypeName()}
 	}
 
 	if err := handle(c, senderAddr, msg); err != nil {
+		c.forgetRequest(senderAddr, msg)
 		return fmt.Errorf("failed to handle %s: %w", msg.MessageTypeName(), err)
 	}
 
 	return nil
 }
 
+// handleSuspension marks the Session suspended or not suspended when Suspend/Resume
+// Notification is received. Nothing is done if the Session is not found, and it is
+// left to HandlerFunc how to respond to such a message.
+func (c *Conn) handleSuspension(senderAddr net.Addr, msg message.Message) {
+	var suspend bool
+	switch msg.MessageType() {
+	case message.MsgTypeSuspendNotification:
+		suspend = true
+	case message.MsgTypeResumeNotification:
+		suspend = false
+	default:
+		return
+	}
+
+	sess, err := c.GetSessionByTEID(msg.TEID(), senderAddr)
+	if err != nil {
+		return
+	}
+
+	if suspend {
+		sess.Suspend()
+	} else {
+		sess.Resume()
+	}
+}
+
 // EnableValidation turns on automatic validation of incoming message.
 // This is expected to be used only after DisableValidation() is used, as the validation
 // is enabled by default.
@@ -380,21 +477,84 @@ func (c *Conn) validate(senderAddr net.Addr, msg message.Message) error {
 
 // SendMessageTo sends a message to addr.
 // Unlike WriteTo, it sets the Sequence Number properly and returns the one used in the message.
+//
+// If the message is an initial message that expects the triggered message, it is
+// retransmitted every T3-RESPONSE until the triggered message comes, up to N3-REQUESTS
+// times. If no response comes after all, the handler set with SetTimeoutHandler is
+// called with *RequestTimeoutError. See SetT3Response and SetN3Requests for how to
+// configure the timer and counter.
 func (c *Conn) SendMessageTo(msg message.Message, addr net.Addr) (uint32, error) {
+	_, err := c.sendMessageTo(msg, addr, false)
+	return msg.Sequence(), err
+}
+
+// Request sends an initial message to raddr and waits for the triggered message to come.
+// The triggered message is looked up by the Sequence Number set to msg and the address
+// of the peer, and returned without being passed to the HandlerFunc.
+//
+// Unlike the HandlerFunc registered for the triggered message, the message returned
+// is not validated by the TEID. This makes it possible to send the message for which
+// no Session exists yet, such as a Create Session Request built by the caller.
+//
+// msg is retransmitted in the same way as SendMessageTo, and *RequestTimeoutError is
+// returned if no response comes after all. It returns ctx.Err() if ctx is done before
+// the response comes.
+func (c *Conn) Request(ctx context.Context, raddr net.Addr, msg message.Message) (message.Message, error) {
+	if !expectsResponse(msg.MessageType()) {
+		return nil, &UnexpectedTypeError{Msg: msg}
+	}
+
+	tx, err := c.sendMessageTo(msg, raddr, true)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case res := <-tx.resCh:
+		return res, nil
+	case err := <-tx.errCh:
+		return nil, err
+	case <-ctx.Done():
+		c.cancelTransaction(tx)
+		return nil, ctx.Err()
+	case <-c.closed():
+		c.cancelTransaction(tx)
+		return nil, net.ErrClosed
+	}
+}
+
+// sendMessageTo sends a message to addr and returns the transaction started for
+// the message, which is nil if the message does not expect any response.
+//
+// If synchronous is true, the triggered message is passed to the transaction
+// returned instead of the HandlerFunc.
+func (c *Conn) sendMessageTo(msg message.Message, addr net.Addr, synchronous bool) (*transaction, error) {
 	seq := c.IncSequence()
 	msg.SetSequenceNumber(seq)
 
 	payload, err := message.Marshal(msg)
 	if err != nil {
-		seq = c.DecSequence()
-		return seq, fmt.Errorf("failed to send %T: %w", msg, err)
+		msg.SetSequenceNumber(c.DecSequence())
+		return nil, fmt.Errorf("failed to send %T: %w", msg, err)
+	}
+
+	c.learnPeer(addr)
+
+	var tx *transaction
+	if expectsResponse(msg.MessageType()) {
+		tx = newTransaction(addr, msg, payload)
+		tx.synchronous = synchronous
+		c.startTransaction(tx)
 	}
 
 	if _, err := c.WriteTo(payload, addr); err != nil {
-		seq = c.DecSequence()
-		return seq, fmt.Errorf("failed to send %T: %w", msg, err)
+		if tx != nil {
+			c.cancelTransaction(tx)
+		}
+		msg.SetSequenceNumber(c.DecSequence())
+		return nil, fmt.Errorf("failed to send %T: %w", msg, err)
 	}
-	return seq, nil
+	return tx, nil
 }
 
 // IncSequence increments the SequenceNumber associated with Conn.
@@ -645,10 +805,78 @@ func (c *Conn) DeleteBearer(teid uint32, sess *Session, ie ...*ie.IE) (uint32, e
 	return seq, nil
 }
 
+// BearerResourceCommand sends a BearerResourceCommand with TEID and IEs given.
+//
+// The PGW responds with Create/Update/Delete Bearer Request on success, or with
+// Bearer Resource Failure Indication on failure; both should be handled with
+// HandlerFunc registered for each message type.
+func (c *Conn) BearerResourceCommand(teid uint32, sess *Session, ie ...*ie.IE) (uint32, error) {
+	msg := message.NewBearerResourceCommand(teid, 0, ie...)
+
+	seq, err := c.SendMessageTo(msg, sess.peerAddr)
+	if err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
+// SuspendNotification sends a SuspendNotification with TEID and IEs given.
+//
+// The Session is marked suspended on the receiver side, not on the sender side.
+// See Session.Suspend for details.
+func (c *Conn) SuspendNotification(teid uint32, sess *Session, ie ...*ie.IE) (uint32, error) {
+	msg := message.NewSuspendNotification(teid, 0, ie...)
+	return c.SendMessageTo(msg, sess.peerAddr)
+}
+
+// ResumeNotification sends a ResumeNotification with TEID and IEs given.
+//
+// The Session is marked not suspended on the receiver side, not on the sender side.
+// See Session.Resume for details.
+func (c *Conn) ResumeNotification(teid uint32, sess *Session, ie ...*ie.IE) (uint32, error) {
+	msg := message.NewResumeNotification(teid, 0, ie...)
+	return c.SendMessageTo(msg, sess.peerAddr)
+}
+
+// DownlinkDataNotification sends a DownlinkDataNotification with TEID and IEs given.
+//
+// It returns ErrSessionSuspended without sending anything if the Session is suspended,
+// as the UE in CS domain cannot be paged for the downlink data.
+func (c *Conn) DownlinkDataNotification(teid uint32, sess *Session, ie ...*ie.IE) (uint32, error) {
+	if sess.IsSuspended() {
+		return 0, ErrSessionSuspended
+	}
+
+	msg := message.NewDownlinkDataNotification(teid, 0, ie...)
+	return c.SendMessageTo(msg, sess.peerAddr)
+}
+
+// ChangeNotification sends a ChangeNotificationRequest with TEID and IEs given.
+func (c *Conn) ChangeNotification(teid uint32, sess *Session, ie ...*ie.IE) (uint32, error) {
+	msg := message.NewChangeNotificationRequest(teid, 0, ie...)
+	return c.SendMessageTo(msg, sess.peerAddr)
+}
+
+// UpdateLocation updates the Location of the Session, and sends a ChangeNotificationRequest
+// with the new UserLocationInformation and IEs given if the change is the one requested
+// to be reported by P-GW with Change Reporting Action.
+//
+// If there is nothing to be reported, it returns 0 without sending anything.
+func (c *Conn) UpdateLocation(teid uint32, sess *Session, loc *Location, ies ...*ie.IE) (uint32, error) {
+	uli := sess.UpdateLocation(loc)
+	if uli == nil {
+		return 0, nil
+	}
+	return c.ChangeNotification(teid, sess, append([]*ie.IE{uli}, ies...)...)
+}
+
 // RespondTo sends a message(specified with "toBeSent" param) in response to a message
 // (specified with "received" param).
 //
 // This exists to make it easier to handle SequenceNumber.
+//
+// The message sent is kept for a while, and sent again instead of calling HandlerFunc
+// when the peer retransmits the same message as "received". See TS29.274 7.6 for details.
 func (c *Conn) RespondTo(raddr net.Addr, received, toBeSent message.Message) error {
 	toBeSent.SetSequenceNumber(received.Sequence())
 	b := make([]byte, toBeSent.MarshalLen())
@@ -660,6 +888,41 @@ func (c *Conn) RespondTo(raddr net.Addr, received, toBeSent message.Message) err
 	if _, err := c.WriteTo(b, raddr); err != nil {
 		return err
 	}
+
+	c.cacheResponse(raddr, received, b)
+	return nil
+}
+
+// RespondWithTriggeredRequest sends a request(specified with "req" param) triggered by
+// a Command(specified with "cmd" param), e.g., Create Bearer Request in response to
+// Bearer Resource Command.
+//
+// Unlike SendMessageTo, the request is sent with the Sequence Number of the Command,
+// so that the sender of the Command can match it. See TS29.274 7.6 for details. The
+// request is retransmitted in the same way as SendMessageTo until the response comes,
+// and also sent again when the peer retransmits the Command.
+func (c *Conn) RespondWithTriggeredRequest(raddr net.Addr, cmd, req message.Message) error {
+	if !isTriggeredBy(req.MessageType(), cmd.MessageType()) {
+		return &UnexpectedTypeError{Msg: req}
+	}
+
+	req.SetSequenceNumber(cmd.Sequence())
+	payload, err := message.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to send %T: %w", req, err)
+	}
+
+	c.learnPeer(raddr)
+
+	tx := newTransaction(raddr, req, payload)
+	c.startTransaction(tx)
+
+	if _, err := c.WriteTo(payload, raddr); err != nil {
+		c.cancelTransaction(tx)
+		return fmt.Errorf("failed to send %T: %w", req, err)
+	}
+
+	c.cacheResponse(raddr, cmd, payload)
 	return nil
 }
 
@@ -702,6 +965,7 @@ func (c *Conn) GetIMSIByTEID(teid uint32, peer net.Addr) (string, error) {
 func (c *Conn) RegisterSession(itei uint32, session *Session) {
 	c.iteiSessionMap.store(itei, session)
 	c.imsiSessionMap.store(session.IMSI, session)
+	c.learnPeer(session.peerAddr)
 
 	session.AddTEID(c.localIfType, itei)
 }
@@ -709,6 +973,7 @@ func (c *Conn) RegisterSession(itei uint32, session *Session) {
 // RemoveSession removes a session registered in a Conn.
 func (c *Conn) RemoveSession(session *Session) {
 	c.imsiSessionMap.delete(session.IMSI)
+	c.ReleaseForwardingTEIDs(session)
 
 	itei, err := session.GetTEID(c.localIfType)
 	if err != nil { // if incoming TEID could not be found for some reason
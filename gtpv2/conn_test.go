@@ -6,6 +6,7 @@ package gtpv2_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -217,3 +218,561 @@ func TestCreateSession(t *testing.T) {
 		t.Fatal("timed out while waiting for validating Create Session Response")
 	}
 }
+
+func TestRetransmission(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer, err := net.ListenPacket("udp", "127.0.0.3"+gtpv2.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer peer.Close()
+
+	cliAddr, err := net.ResolveUDPAddr("udp", "127.0.0.4"+gtpv2.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cliConn := gtpv2.NewConn(cliAddr, gtpv2.IFTypeS11MMEGTPC, 0)
+	if err := cliConn.Listen(ctx); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		if err := cliConn.Serve(ctx); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	cliConn.SetT3Response(100 * time.Millisecond)
+	cliConn.SetN3Requests(2)
+
+	errCh := make(chan error, 1)
+	cliConn.SetTimeoutHandler(func(c *gtpv2.Conn, peerAddr net.Addr, msg message.Message, err error) {
+		errCh <- err
+	})
+
+	seq, err := cliConn.EchoRequest(peer.LocalAddr())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 1500)
+	for i := 0; i < 3; i++ {
+		if err := peer.SetReadDeadline(time.Now().Add(1 * time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, _, err := peer.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("failed to receive Echo Request #%d: %v", i, err)
+		}
+		msg, err := message.Parse(buf[:n])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := msg.Sequence(); got != seq {
+			t.Errorf("invalid sequence number in #%d. got: %d, want: %d", i, got, seq)
+		}
+	}
+
+	select {
+	case err := <-errCh:
+		var toErr *gtpv2.RequestTimeoutError
+		if !errors.As(err, &toErr) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if toErr.Seq != seq {
+			t.Errorf("invalid sequence number in error. got: %d, want: %d", toErr.Seq, seq)
+		}
+	case <-time.After(1 * time.Second):
+		t.Fatal("timed out while waiting for the request to time out")
+	}
+
+	if count := cliConn.PendingRequestCount(); count != 0 {
+		t.Errorf("wrong PendingRequestCount. want %d, got: %d", 0, count)
+	}
+}
+
+func TestDuplicateRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srvAddr, err := net.ResolveUDPAddr("udp", "127.0.0.5"+gtpv2.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srvConn := gtpv2.NewConn(srvAddr, gtpv2.IFTypeS11S4SGWGTPC, 0)
+
+	handled := make(chan struct{}, 2)
+	srvConn.AddHandler(
+		message.MsgTypeCreateSessionRequest,
+		func(c *gtpv2.Conn, cliAddr net.Addr, msg message.Message) error {
+			handled <- struct{}{}
+			csRsp := message.NewCreateSessionResponse(
+				0, 0, ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+			)
+			return c.RespondTo(cliAddr, msg, csRsp)
+		},
+	)
+	if err := srvConn.Listen(ctx); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		if err := srvConn.Serve(ctx); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	peer, err := net.ListenPacket("udp", "127.0.0.6"+gtpv2.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer peer.Close()
+
+	req, err := message.NewCreateSessionRequest(0, 0x123456, ie.NewIMSI("123451234567890")).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 1500)
+	for i := 0; i < 2; i++ {
+		if _, err := peer.WriteTo(req, srvAddr); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := peer.SetReadDeadline(time.Now().Add(1 * time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, _, err := peer.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("failed to receive Create Session Response #%d: %v", i, err)
+		}
+		msg, err := message.Parse(buf[:n])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := msg.(*message.CreateSessionResponse); !ok {
+			t.Fatalf("got unexpected type of message: %T", msg)
+		}
+		if got := msg.Sequence(); got != 0x123456 {
+			t.Errorf("invalid sequence number in #%d. got: %d, want: %d", i, got, 0x123456)
+		}
+	}
+
+	if got := len(handled); got != 1 {
+		t.Errorf("handler called unexpectedly. want %d, got: %d", 1, got)
+	}
+
+	// the sender of unsolicited messages should not be the target of the path management.
+	if srvConn.IsPathUp(peer.LocalAddr()) {
+		t.Error("unsolicited peer is unexpectedly watched")
+	}
+}
+
+func TestRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srvAddr, err := net.ResolveUDPAddr("udp", "127.0.0.7"+gtpv2.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srvConn := gtpv2.NewConn(srvAddr, gtpv2.IFTypeS11S4SGWGTPC, 0)
+	srvConn.AddHandler(
+		message.MsgTypeCreateSessionRequest,
+		func(c *gtpv2.Conn, cliAddr net.Addr, msg message.Message) error {
+			csRsp := message.NewCreateSessionResponse(
+				0xffffffff, 0, ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+			)
+			return c.RespondTo(cliAddr, msg, csRsp)
+		},
+	)
+	if err := srvConn.Listen(ctx); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		if err := srvConn.Serve(ctx); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	cliAddr, err := net.ResolveUDPAddr("udp", "127.0.0.8"+gtpv2.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cliConn := gtpv2.NewConn(cliAddr, gtpv2.IFTypeS11MMEGTPC, 0)
+	if err := cliConn.Listen(ctx); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		if err := cliConn.Serve(ctx); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	t.Run("Response", func(t *testing.T) {
+		reqCtx, reqCancel := context.WithTimeout(ctx, 5*time.Second)
+		defer reqCancel()
+
+		csReq := message.NewCreateSessionRequest(0, 0, ie.NewIMSI("123451234567890"))
+		msg, err := cliConn.Request(reqCtx, srvAddr, csReq)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		csRsp, ok := msg.(*message.CreateSessionResponse)
+		if !ok {
+			t.Fatalf("got unexpected type of message: %T", msg)
+		}
+		if got, want := csRsp.Sequence(), csReq.Sequence(); got != want {
+			t.Errorf("invalid sequence number. got: %d, want: %d", got, want)
+		}
+		if got, want := csRsp.TEID(), uint32(0xffffffff); got != want {
+			t.Errorf("invalid TEID. got: %#x, want: %#x", got, want)
+		}
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		reqCtx, reqCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer reqCancel()
+
+		// no handler is registered for Delete Session Request.
+		dsReq := message.NewDeleteSessionRequest(0, 0)
+		if _, err := cliConn.Request(reqCtx, srvAddr, dsReq); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if count := cliConn.PendingRequestCount(); count != 0 {
+			t.Errorf("wrong PendingRequestCount. want %d, got: %d", 0, count)
+		}
+	})
+}
+
+func TestPathManagement(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer, err := net.ListenPacket("udp", "127.0.0.9"+gtpv2.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer peer.Close()
+
+	// respond to Echo Request twice with different Restart Counter, and then
+	// stop responding to make the path down.
+	go func() {
+		buf := make([]byte, 1500)
+		for counter := uint8(1); counter <= 2; counter++ {
+			n, raddr, err := peer.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			req, err := message.Parse(buf[:n])
+			if err != nil {
+				return
+			}
+			res, err := message.NewEchoResponse(req.Sequence(), ie.NewRecovery(counter)).Marshal()
+			if err != nil {
+				return
+			}
+			if _, err := peer.WriteTo(res, raddr); err != nil {
+				return
+			}
+		}
+	}()
+
+	cliAddr, err := net.ResolveUDPAddr("udp", "127.0.0.10"+gtpv2.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cliConn := gtpv2.NewConn(cliAddr, gtpv2.IFTypeS11MMEGTPC, 0)
+	if err := cliConn.Listen(ctx); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		if err := cliConn.Serve(ctx); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	sess := gtpv2.NewSession(peer.LocalAddr(), &gtpv2.Subscriber{IMSI: "001011234567891"})
+	_ = sess.Activate()
+	cliConn.RegisterSession(1, sess)
+
+	evCh := make(chan *gtpv2.PathEvent, 10)
+	cliConn.SetPathEventHandler(func(c *gtpv2.Conn, ev *gtpv2.PathEvent) {
+		evCh <- ev
+	})
+	cliConn.EnableSessionPurge()
+	cliConn.SetT3Response(50 * time.Millisecond)
+	cliConn.SetN3Requests(1)
+	cliConn.AddPeer(peer.LocalAddr())
+	cliConn.StartPathManagement(ctx, 100*time.Millisecond)
+
+	for _, want := range []gtpv2.PathEventType{gtpv2.PathEventPeerRestarted, gtpv2.PathEventDown} {
+		select {
+		case ev := <-evCh:
+			if ev.Type != want {
+				t.Fatalf("unexpected event. want: %s, got: %s", want, ev.Type)
+			}
+			if ev.Peer.String() != peer.LocalAddr().String() {
+				t.Errorf("unexpected peer in event: %s", ev.Peer)
+			}
+			if ev.Type == gtpv2.PathEventPeerRestarted {
+				if ev.RestartCounter != 2 {
+					t.Errorf("wrong RestartCounter. want %d, got: %d", 2, ev.RestartCounter)
+				}
+				if len(ev.PurgedSessions) != 1 {
+					t.Errorf("wrong number of purged Sessions. want %d, got: %d", 1, len(ev.PurgedSessions))
+				}
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out while waiting for %s", want)
+		}
+	}
+
+	if count := cliConn.SessionCount(); count != 0 {
+		t.Errorf("wrong SessionCount in cliConn. want %d, got: %d", 0, count)
+	}
+	if cliConn.IsPathUp(peer.LocalAddr()) {
+		t.Error("path is unexpectedly up")
+	}
+
+	cliConn.RemovePeer(peer.LocalAddr())
+	if _, ok := cliConn.PeerRestartCounter(peer.LocalAddr()); ok {
+		t.Error("peer is unexpectedly left after RemovePeer")
+	}
+}
+
+func TestSuspendResumeNotification(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	doneCh := make(chan struct{})
+	cliConn, srvConn, err := setup(ctx, doneCh)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rspOK := make(chan struct{})
+	cliConn.AddHandler(
+		message.MsgTypeCreateSessionResponse,
+		func(c *gtpv2.Conn, srvAddr net.Addr, msg message.Message) error {
+			session, err := c.GetSessionByTEID(msg.TEID(), srvAddr)
+			if err != nil {
+				return err
+			}
+			otei, err := msg.(*message.CreateSessionResponse).SenderFTEIDC.TEID()
+			if err != nil {
+				return err
+			}
+			session.AddTEID(gtpv2.IFTypeS11S4SGWGTPC, otei)
+			rspOK <- struct{}{}
+			return nil
+		},
+	)
+	ddnCh := make(chan struct{}, 1)
+	cliConn.AddHandler(
+		message.MsgTypeDownlinkDataNotification,
+		func(c *gtpv2.Conn, srvAddr net.Addr, msg message.Message) error {
+			ddnCh <- struct{}{}
+			return c.RespondTo(srvAddr, msg, message.NewDownlinkDataNotificationAcknowledge(
+				0, 0, ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+			))
+		},
+	)
+
+	ackCh := make(chan bool, 1)
+	for _, typ := range []uint8{message.MsgTypeSuspendNotification, message.MsgTypeResumeNotification} {
+		srvConn.AddHandler(typ, func(c *gtpv2.Conn, cliAddr net.Addr, msg message.Message) error {
+			session, err := c.GetSessionByTEID(msg.TEID(), cliAddr)
+			if err != nil {
+				return err
+			}
+			ackCh <- session.IsSuspended()
+
+			cause := ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil)
+			if msg.MessageType() == message.MsgTypeSuspendNotification {
+				return c.RespondTo(cliAddr, msg, message.NewSuspendAcknowledge(0, 0, cause))
+			}
+			return c.RespondTo(cliAddr, msg, message.NewResumeAcknowledge(0, 0, cause))
+		})
+	}
+
+	fTEID := cliConn.NewSenderFTEID("127.0.0.1", "")
+	cliSess, _, err := cliConn.CreateSession(srvConn.LocalAddr(), ie.NewIMSI("123451234567890"), fTEID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ch := range []chan struct{}{rspOK, doneCh} {
+		select {
+		case <-ch:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out while waiting for Create Session to complete")
+		}
+	}
+
+	sgwTEID, err := cliSess.GetTEID(gtpv2.IFTypeS11S4SGWGTPC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srvSess, err := srvConn.GetSessionByIMSI("123451234567890")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mmeTEID, err := srvSess.GetTEID(gtpv2.IFTypeS11MMEGTPC)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		description string
+		notify      func(teid uint32, sess *gtpv2.Session, ies ...*ie.IE) (uint32, error)
+		suspended   bool
+	}{
+		{"Suspend", cliConn.SuspendNotification, true},
+		{"Resume", cliConn.ResumeNotification, false},
+	}
+
+	for _, c := range cases {
+		if _, err := c.notify(sgwTEID, cliSess); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case got := <-ackCh:
+			if got != c.suspended {
+				t.Errorf("%s: wrong suspended state in HandlerFunc. want %v, got: %v", c.description, c.suspended, got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: timed out while waiting for the notification to be handled", c.description)
+		}
+		if cliSess.IsSuspended() {
+			t.Errorf("%s: Session on the sender side is unexpectedly suspended", c.description)
+		}
+
+		_, err := srvConn.DownlinkDataNotification(mmeTEID, srvSess)
+		if c.suspended {
+			if !errors.Is(err, gtpv2.ErrSessionSuspended) {
+				t.Errorf("%s: unexpected error. want %v, got: %v", c.description, gtpv2.ErrSessionSuspended, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-ddnCh:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: timed out while waiting for Downlink Data Notification", c.description)
+		}
+	}
+}
+
+func TestDialFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer, err := net.ListenPacket("udp", "127.0.0.11"+gtpv2.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer peer.Close()
+
+	// respond to Echo Request with a truncated header that cannot be parsed.
+	go func() {
+		buf := make([]byte, 1500)
+		_, raddr, err := peer.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		if _, err := peer.WriteTo([]byte{0x48, 0x02, 0x00, 0x08}, raddr); err != nil {
+			return
+		}
+	}()
+
+	cliAddr, err := net.ResolveUDPAddr("udp", "127.0.0.12"+gtpv2.GTPCPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gtpv2.Dial(ctx, cliAddr, peer.LocalAddr(), gtpv2.IFTypeS11MMEGTPC, 0); err == nil {
+		t.Fatal("Dial unexpectedly succeeded")
+	}
+
+	// the local address should be available again, as Dial releases it on failure.
+	conn, err := net.ListenPacket("udp", cliAddr.String())
+	if err != nil {
+		t.Fatalf("local address is not released: %v", err)
+	}
+	conn.Close()
+}
+
+func TestRespondWithTriggeredRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cliConn, srvConn, err := setup(ctx, make(chan struct{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cliConn.SetTimeoutHandler(func(c *gtpv2.Conn, raddr net.Addr, msg message.Message, err error) {
+		t.Errorf("unexpected timeout: %v", err)
+	})
+	srvConn.SetTimeoutHandler(func(c *gtpv2.Conn, raddr net.Addr, msg message.Message, err error) {
+		t.Errorf("unexpected timeout: %v", err)
+	})
+
+	srvConn.AddHandler(
+		message.MsgTypeBearerResourceCommand,
+		func(c *gtpv2.Conn, cliAddr net.Addr, msg message.Message) error {
+			return c.RespondWithTriggeredRequest(cliAddr, msg, message.NewCreateBearerRequest(
+				0, 0, ie.NewEPSBearerID(5),
+			))
+		},
+	)
+	doneCh := make(chan struct{})
+	srvConn.AddHandler(
+		message.MsgTypeCreateBearerResponse,
+		func(c *gtpv2.Conn, cliAddr net.Addr, msg message.Message) error {
+			close(doneCh)
+			return nil
+		},
+	)
+
+	seqCh := make(chan uint32, 1)
+	cliConn.AddHandler(
+		message.MsgTypeCreateBearerRequest,
+		func(c *gtpv2.Conn, srvAddr net.Addr, msg message.Message) error {
+			seqCh <- msg.Sequence()
+			return c.RespondTo(srvAddr, msg, message.NewCreateBearerResponse(
+				0, 0, ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+			))
+		},
+	)
+
+	seq, err := cliConn.SendMessageTo(
+		message.NewBearerResourceCommand(0, 0, ie.NewEPSBearerID(5), ie.NewProcedureTransactionID(1)),
+		srvConn.LocalAddr(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case got := <-seqCh:
+		if got != seq {
+			t.Errorf("wrong sequence number in Create Bearer Request. want %d, got: %d", seq, got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out while waiting for Create Bearer Request")
+	}
+	select {
+	case <-doneCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out while waiting for Create Bearer Response")
+	}
+
+	if got := cliConn.PendingRequestCount(); got != 0 {
+		t.Errorf("Bearer Resource Command is still pending: %d", got)
+	}
+	if got := srvConn.PendingRequestCount(); got != 0 {
+		t.Errorf("Create Bearer Request is still pending: %d", got)
+	}
+}
@@ -4,6 +4,8 @@
 
 package gtpv2
 
+import "github.com/wmnsk/go-gtp/gtpv2/ie"
+
 // Fixes for the constants with wrong names (original ones are kept for compatibility).
 const (
 	ContIDMSSupportOfNetworkRequestedBearerControlIndicator uint16 = 5  // ContIDMSSupportofNetworkRequestedBearerControlIndicator
@@ -310,3 +312,26 @@ const (
 	DaylightSavingPlusOneHour
 	DaylightSavingPlusTwoHours
 )
+
+// Change Reporting Action definitions.
+const (
+	ChangeReportingActionStopReporting uint8 = iota
+	ChangeReportingActionStartReportingCGISAI
+	ChangeReportingActionStartReportingRAI
+	ChangeReportingActionStartReportingTAI
+	ChangeReportingActionStartReportingECGI
+	ChangeReportingActionStartReportingCGISAIAndRAI
+	ChangeReportingActionStartReportingTAIAndECGI
+	ChangeReportingActionStartReportingMacroENBIDAndExtendedMacroENBID
+	ChangeReportingActionStartReportingTAIMacroENBIDAndExtendedMacroENBID
+)
+
+// Security Mode definitions, re-exported from ie package.
+const (
+	SecurityModeGSMKeyAndTriplets                = ie.SecurityModeGSMKeyAndTriplets
+	SecurityModeUMTSKeyUsedCipherAndQuintuplets  = ie.SecurityModeUMTSKeyUsedCipherAndQuintuplets
+	SecurityModeGSMKeyUsedCipherAndQuintuplets   = ie.SecurityModeGSMKeyUsedCipherAndQuintuplets
+	SecurityModeUMTSKeyAndQuintuplets            = ie.SecurityModeUMTSKeyAndQuintuplets
+	SecurityModeEPSSecurityContextAndQuadruplets = ie.SecurityModeEPSSecurityContextAndQuadruplets
+	SecurityModeUMTSKeyQuadrupletsAndQuintuplets = ie.SecurityModeUMTSKeyQuadrupletsAndQuintuplets
+)
@@ -18,6 +18,9 @@ var (
 	// ErrTimeout indicates that a handler failed to complete its work due to the
 	// absence of message expected to come from another endpoint.
 	ErrTimeout = errors.New("timed out")
+
+	// ErrSessionSuspended indicates that the message cannot be sent as the Session is suspended.
+	ErrSessionSuspended = errors.New("session is suspended")
 )
 
 // CauseNotOKError indicates that the value in Cause IE is not OK.
@@ -93,6 +96,19 @@ func (e *InvalidSequenceError) Error() string {
 	return fmt.Sprintf("got invalid Sequence Number: %d", e.Seq)
 }
 
+// RequestTimeoutError indicates that no triggered message is received for the initial
+// message even after it is retransmitted N3-REQUESTS times.
+type RequestTimeoutError struct {
+	MsgType string
+	Seq     uint32
+	Peer    string
+}
+
+// Error returns the message that timed out and its peer.
+func (e *RequestTimeoutError) Error() string {
+	return fmt.Sprintf("no response to %s(Sequence Number: %d) from %s", e.MsgType, e.Seq, e.Peer)
+}
+
 // InvalidTEIDError indicates that the TEID value is different from expected one or
 // not registered in TEIDMap.
 type InvalidTEIDError struct {
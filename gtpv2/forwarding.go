@@ -0,0 +1,99 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+// NewForwardingFTEID creates a new F-TEID for the indirect data forwarding tunnel
+// of the Session, with random TEID value that is unique among the forwarding
+// tunnels on Conn.
+//
+// Unlike NewSenderFTEID, the interface type should be given explicitly, as it is
+// the one of the user plane(e.g., IFTypeS1USGWGTPU). The instance of the IE should
+// be set by the caller to match the position in Bearer Context, which varies
+// depending on the direction of forwarding. See TS29.274 7.2.18 for details.
+//
+// The TEIDs allocated are kept until ReleaseForwardingTEIDs or RemoveSession is
+// called with the Session.
+func (c *Conn) NewForwardingFTEID(sess *Session, ifType uint8, v4, v6 string) *ie.IE {
+	var teid uint32
+	for try := uint32(0); try < 0xffff; try++ {
+		t := generateRandomUint32()
+		if t == 0 {
+			continue
+		}
+
+		if ok := c.fwdTEIDSessionMap.tryStore(t, sess); !ok {
+			continue
+		}
+
+		teid = t
+		break
+	}
+
+	if teid == 0 {
+		return nil
+	}
+	return ie.NewFullyQualifiedTEID(ifType, teid, v4, v6)
+}
+
+// GetSessionByForwardingTEID returns Session looked up by the TEID allocated for
+// the indirect data forwarding tunnel with NewForwardingFTEID.
+func (c *Conn) GetSessionByForwardingTEID(teid uint32) (*Session, error) {
+	sess, ok := c.fwdTEIDSessionMap.load(teid)
+	if !ok || sess == nil {
+		return nil, &InvalidTEIDError{TEID: teid}
+	}
+	return sess, nil
+}
+
+// ForwardingTEIDs returns the TEIDs allocated for the indirect data forwarding
+// tunnels of the Session.
+func (c *Conn) ForwardingTEIDs(sess *Session) []uint32 {
+	var teids []uint32
+	c.fwdTEIDSessionMap.rangeWithFunc(func(k, v interface{}) bool {
+		if s, ok := v.(*Session); ok && s == sess {
+			teids = append(teids, k.(uint32))
+		}
+		return true
+	})
+	return teids
+}
+
+// ReleaseForwardingTEIDs releases all the TEIDs allocated for the indirect data
+// forwarding tunnels of the Session. This is typically called when the Delete
+// Indirect Data Forwarding Tunnel Request is received.
+func (c *Conn) ReleaseForwardingTEIDs(sess *Session) {
+	for _, teid := range c.ForwardingTEIDs(sess) {
+		c.fwdTEIDSessionMap.delete(teid)
+	}
+}
+
+// CreateIndirectDataForwardingTunnel sends a CreateIndirectDataForwardingTunnelRequest
+// with TEID and IEs given.
+func (c *Conn) CreateIndirectDataForwardingTunnel(teid uint32, sess *Session, ie ...*ie.IE) (uint32, error) {
+	msg := message.NewCreateIndirectDataForwardingTunnelRequest(teid, 0, ie...)
+
+	seq, err := c.SendMessageTo(msg, sess.peerAddr)
+	if err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
+// DeleteIndirectDataForwardingTunnel sends a DeleteIndirectDataForwardingTunnelRequest
+// with TEID and IEs given.
+func (c *Conn) DeleteIndirectDataForwardingTunnel(teid uint32, sess *Session, ie ...*ie.IE) (uint32, error) {
+	msg := message.NewDeleteIndirectDataForwardingTunnelRequest(teid, 0, ie...)
+
+	seq, err := c.SendMessageTo(msg, sess.peerAddr)
+	if err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
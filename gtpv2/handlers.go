@@ -54,10 +54,14 @@ func newDefaultMsgHandlerMap() *msgHandlerMap {
 func handleEchoRequest(c *Conn, senderAddr net.Addr, msg message.Message) error {
 	// this should never happen, as the type should have been assured by
 	// msgHandlerMap before this function is called.
-	if _, ok := msg.(*message.EchoRequest); !ok {
+	echo, ok := msg.(*message.EchoRequest)
+	if !ok {
 		return &UnexpectedTypeError{Msg: msg}
 	}
 
+	// check if the peer has restarted.
+	c.handleRecovery(senderAddr, echo.Recovery)
+
 	// respond with EchoResponse.
 	return c.RespondTo(
 		senderAddr, msg, message.NewEchoResponse(0, ie.NewRecovery(c.RestartCounter)),
@@ -67,11 +71,13 @@ func handleEchoRequest(c *Conn, senderAddr net.Addr, msg message.Message) error
 func handleEchoResponse(c *Conn, senderAddr net.Addr, msg message.Message) error {
 	// this should never happen, as the type should have been assured by
 	// msgHandlerMap before this function is called.
-	if _, ok := msg.(*message.EchoResponse); !ok {
+	echo, ok := msg.(*message.EchoResponse)
+	if !ok {
 		return &UnexpectedTypeError{Msg: msg}
 	}
 
-	// do nothing.
+	// check if the peer has restarted.
+	c.handleRecovery(senderAddr, echo.Recovery)
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
 )
 
 var testConn *gtpv2.Conn
@@ -150,3 +151,115 @@ func TestRemoveSessionByIMSI(t *testing.T) {
 	s.AddTEID(gtpv2.IFTypeS11MMEGTPC, uint32(0))
 	testConn.RegisterSession(0, s)
 }
+
+func TestForwardingFTEID(t *testing.T) {
+	conn := gtpv2.NewConn(dummyAddr, gtpv2.IFTypeS11S4SGWGTPC, 0)
+	sess := gtpv2.NewSession(dummyAddr, &gtpv2.Subscriber{IMSI: "001011234567895"})
+	conn.RegisterSession(1, sess)
+
+	dl := conn.NewForwardingFTEID(sess, gtpv2.IFTypeS1USGWGTPU, "1.1.1.1", "").WithInstance(3)
+	ul := conn.NewForwardingFTEID(sess, gtpv2.IFTypeS1USGWGTPU, "1.1.1.1", "").WithInstance(4)
+	if dl == nil || ul == nil {
+		t.Fatal("failed to allocate forwarding F-TEID")
+	}
+
+	for _, fteid := range []*ie.IE{dl, ul} {
+		got, err := conn.GetSessionByForwardingTEID(fteid.MustTEID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != sess {
+			t.Errorf("Got wrong session by forwarding TEID %#x: %s", fteid.MustTEID(), got.IMSI)
+		}
+	}
+	if n := len(conn.ForwardingTEIDs(sess)); n != 2 {
+		t.Errorf("Got wrong number of forwarding TEIDs. want: %d, got: %d", 2, n)
+	}
+
+	conn.RemoveSession(sess)
+	if n := len(conn.ForwardingTEIDs(sess)); n != 0 {
+		t.Errorf("Forwarding TEIDs not released: %d", n)
+	}
+	if _, err := conn.GetSessionByForwardingTEID(dl.MustTEID()); err == nil {
+		t.Error("Session can still be looked up by forwarding TEID")
+	}
+}
+
+func TestSuspendResume(t *testing.T) {
+	sess := gtpv2.NewSession(dummyAddr, &gtpv2.Subscriber{IMSI: "001011234567896"})
+	if sess.IsSuspended() {
+		t.Fatal("Session is suspended at the beginning")
+	}
+
+	sess.Suspend()
+	if !sess.IsSuspended() {
+		t.Error("Session is not suspended after Suspend")
+	}
+
+	sess.Resume()
+	if sess.IsSuspended() {
+		t.Error("Session is still suspended after Resume")
+	}
+}
+
+func TestUpdateLocation(t *testing.T) {
+	sess := gtpv2.NewSession(dummyAddr, &gtpv2.Subscriber{
+		IMSI:     "001011234567896",
+		Location: &gtpv2.Location{MCC: "001", MNC: "01", TAI: 0x0001, ECI: 0x00000101},
+	})
+
+	// nothing should be reported unless P-GW requests.
+	if uli := sess.UpdateLocation(&gtpv2.Location{MCC: "001", MNC: "01", TAI: 0x0002, ECI: 0x00000101}); uli != nil {
+		t.Errorf("got ULI without Change Reporting Action: %v", uli)
+	}
+
+	sess.SetChangeReportingAction(gtpv2.ChangeReportingActionStartReportingTAI)
+	if got := sess.ChangeReportingAction(); got != gtpv2.ChangeReportingActionStartReportingTAI {
+		t.Fatalf("wrong Change Reporting Action. want %d, got: %d", gtpv2.ChangeReportingActionStartReportingTAI, got)
+	}
+
+	// ECGI is not requested to be reported.
+	if uli := sess.UpdateLocation(&gtpv2.Location{MCC: "001", MNC: "01", TAI: 0x0002, ECI: 0x00000102}); uli != nil {
+		t.Errorf("got ULI for ECGI change: %v", uli)
+	}
+
+	uli := sess.UpdateLocation(&gtpv2.Location{MCC: "001", MNC: "01", TAI: 0x0003, ECI: 0x00000102})
+	if uli == nil {
+		t.Fatal("got no ULI for TAI change")
+	}
+	fields, err := uli.UserLocationInformation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields.TAI == nil || fields.TAI.TAC != 0x0003 {
+		t.Errorf("wrong TAI in ULI: %+v", fields.TAI)
+	}
+	if fields.ECGI != nil {
+		t.Errorf("unexpected ECGI in ULI: %+v", fields.ECGI)
+	}
+	if sess.TAI != 0x0003 {
+		t.Errorf("Location not updated. want TAI %#x, got: %#x", 0x0003, sess.TAI)
+	}
+
+	// nothing should be reported if none of the requested information is available.
+	if uli := sess.UpdateLocation(&gtpv2.Location{MCC: "001", MNC: "01", ECI: 0x00000103}); uli != nil {
+		t.Errorf("got ULI without TAI: %v", uli)
+	}
+
+	sess.SetChangeReportingAction(gtpv2.ChangeReportingActionStartReportingMacroENBIDAndExtendedMacroENBID)
+	if uli := sess.UpdateLocation(&gtpv2.Location{MCC: "001", MNC: "01", TAI: 0x0003, ECI: 0x00000103}); uli != nil {
+		t.Errorf("got ULI without Macro eNodeB ID: %v", uli)
+	}
+
+	uli = sess.UpdateLocation(&gtpv2.Location{MCC: "001", MNC: "01", MeNBI: 0x00001})
+	if uli == nil {
+		t.Fatal("got no ULI for Macro eNodeB ID change")
+	}
+	fields, err = uli.UserLocationInformation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fields.MENBI == nil || fields.EMENBI != nil {
+		t.Errorf("wrong eNodeB IDs in ULI: %+v, %+v", fields.MENBI, fields.EMENBI)
+	}
+}
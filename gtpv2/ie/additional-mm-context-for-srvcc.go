@@ -0,0 +1,108 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewAdditionalMMContextForSRVCC creates a new AdditionalMMContextForSRVCC IE.
+//
+// Each value should be the content of the IE defined in TS 24.008, without
+// the IEI and the Length octets. nil can be given to omit it.
+func NewAdditionalMMContextForSRVCC(msClassmark2, msClassmark3, supportedCodecList []byte) *IE {
+	v := NewAdditionalMMContextForSRVCCFields(msClassmark2, msClassmark3, supportedCodecList)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(AdditionalMMContextForSRVCC, 0x00, b)
+}
+
+// AdditionalMMContextForSRVCC returns AdditionalMMContextForSRVCC in
+// AdditionalMMContextForSRVCCFields type if the type of IE matches.
+func (i *IE) AdditionalMMContextForSRVCC() (*AdditionalMMContextForSRVCCFields, error) {
+	switch i.Type {
+	case AdditionalMMContextForSRVCC:
+		return ParseAdditionalMMContextForSRVCCFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// AdditionalMMContextForSRVCCFields is a set of fields in AdditionalMMContextForSRVCC IE.
+type AdditionalMMContextForSRVCCFields struct {
+	MSClassmark2       []byte
+	MSClassmark3       []byte
+	SupportedCodecList []byte
+}
+
+// NewAdditionalMMContextForSRVCCFields creates a new AdditionalMMContextForSRVCCFields.
+func NewAdditionalMMContextForSRVCCFields(msClassmark2, msClassmark3, supportedCodecList []byte) *AdditionalMMContextForSRVCCFields {
+	return &AdditionalMMContextForSRVCCFields{
+		MSClassmark2:       msClassmark2,
+		MSClassmark3:       msClassmark3,
+		SupportedCodecList: supportedCodecList,
+	}
+}
+
+// Marshal serializes AdditionalMMContextForSRVCCFields.
+func (f *AdditionalMMContextForSRVCCFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes AdditionalMMContextForSRVCCFields.
+func (f *AdditionalMMContextForSRVCCFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	offset := 0
+	for _, v := range [][]byte{f.MSClassmark2, f.MSClassmark3, f.SupportedCodecList} {
+		b[offset] = uint8(len(v))
+		offset++
+		offset += copy(b[offset:], v)
+	}
+
+	return nil
+}
+
+// ParseAdditionalMMContextForSRVCCFields decodes AdditionalMMContextForSRVCCFields.
+func ParseAdditionalMMContextForSRVCCFields(b []byte) (*AdditionalMMContextForSRVCCFields, error) {
+	f := &AdditionalMMContextForSRVCCFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into AdditionalMMContextForSRVCCFields.
+func (f *AdditionalMMContextForSRVCCFields) UnmarshalBinary(b []byte) error {
+	var (
+		offset int
+		err    error
+	)
+
+	f.MSClassmark2, offset, err = decodeLengthValue(b, offset)
+	if err != nil {
+		return err
+	}
+	f.MSClassmark3, offset, err = decodeLengthValue(b, offset)
+	if err != nil {
+		return err
+	}
+	f.SupportedCodecList, _, err = decodeLengthValue(b, offset)
+	return err
+}
+
+// MarshalLen returns the serial length of AdditionalMMContextForSRVCCFields in int.
+func (f *AdditionalMMContextForSRVCCFields) MarshalLen() int {
+	return 3 + len(f.MSClassmark2) + len(f.MSClassmark3) + len(f.SupportedCodecList)
+}
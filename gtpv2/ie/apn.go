@@ -5,6 +5,7 @@
 package ie
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -24,7 +25,21 @@ func NewAccessPointName(apn string) *IE {
 
 // AccessPointName returns AccessPointName in string if the type of IE matches.
 func (i *IE) AccessPointName() (string, error) {
-	if i.Type != AccessPointName {
+	switch i.Type {
+	case AccessPointName:
+	case PDNConnection:
+		ies, err := i.PDNConnection()
+		if err != nil {
+			return "", fmt.Errorf("failed to retrieve AccessPointName: %w", err)
+		}
+
+		for _, child := range ies {
+			if child.Type == AccessPointName {
+				return child.AccessPointName()
+			}
+		}
+		return "", ErrIENotFound
+	default:
 		return "", &InvalidTypeError{Type: i.Type}
 	}
 
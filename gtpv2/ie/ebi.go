@@ -15,6 +15,8 @@ func NewEPSBearerID(ebi uint8) *IE {
 }
 
 // EPSBearerID returns EPSBearerID if the type of IE matches.
+//
+// For PDNConnection, this returns the Linked EPS Bearer ID.
 func (i *IE) EPSBearerID() (uint8, error) {
 	switch i.Type {
 	case EPSBearerID:
@@ -29,6 +31,18 @@ func (i *IE) EPSBearerID() (uint8, error) {
 			return 0, fmt.Errorf("failed to retrieve EPSBearerID: %w", err)
 		}
 
+		for _, child := range ies {
+			if child.Type == EPSBearerID {
+				return child.EPSBearerID()
+			}
+		}
+		return 0, ErrIENotFound
+	case PDNConnection:
+		ies, err := i.PDNConnection()
+		if err != nil {
+			return 0, fmt.Errorf("failed to retrieve EPSBearerID: %w", err)
+		}
+
 		for _, child := range ies {
 			if child.Type == EPSBearerID {
 				return child.EPSBearerID()
@@ -42,6 +42,10 @@ func TestIEs(t *testing.T) {
 			"Recovery",
 			ie.NewRecovery(0xff),
 			[]byte{0x03, 0x00, 0x01, 0x00, 0xff},
+		}, {
+			"STNSR",
+			ie.NewSTNSR(0x91, "819012345678"),
+			[]byte{0x33, 0x00, 0x07, 0x00, 0x91, 0x18, 0x09, 0x21, 0x43, 0x65, 0x87},
 		}, {
 			"AccessPointName",
 			ie.NewAccessPointName("some.apn.example"),
@@ -541,6 +545,22 @@ func TestIEs(t *testing.T) {
 			"ChargingCharacteristics",
 			ie.NewChargingCharacteristics(0xffff),
 			[]byte{0x5f, 0x00, 0x02, 0x00, 0xff, 0xff},
+		}, {
+			"TraceInformation",
+			ie.NewTraceInformation("123", "45", 1, []byte{0x01, 0x02}, 0x0001, 2, []byte{0xff}, "1.1.1.1"),
+			[]byte{
+				0x60, 0x00, 0x22, 0x00,
+				// MCC/MNC, Trace ID
+				0x21, 0xf3, 0x54, 0x00, 0x00, 0x01,
+				// Triggering Events
+				0x01, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				// List of NE Types, Session Trace Depth
+				0x00, 0x01, 0x02,
+				// List of Interfaces
+				0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				// IP Address of Trace Collection Entity
+				0x01, 0x01, 0x01, 0x01,
+			},
 		}, {
 			"BearerFlags",
 			ie.NewBearerFlags(1, 1, 1, 1),
@@ -553,6 +573,93 @@ func TestIEs(t *testing.T) {
 			"ProcedureTransactionID",
 			ie.NewProcedureTransactionID(1),
 			[]byte{0x64, 0x00, 0x01, 0x00, 0x01},
+		}, {
+			"MMContextGSMKeyAndTriplets",
+			ie.NewMMContextGSMKeyAndTriplets(&ie.MMContextFields{
+				KSI:        1,
+				UsedCipher: 1,
+				Kc:         []byte{0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11},
+				Triplets: []*ie.AuthenticationTriplet{
+					ie.NewAuthenticationTriplet(
+						[]byte{0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22},
+						[]byte{0x33, 0x33, 0x33, 0x33},
+						[]byte{0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44},
+					),
+				},
+				DRXParameter:        []byte{0x0a, 0x0b},
+				MSNetworkCapability: []byte{0xe5, 0xe0},
+				MEI:                 "123450123456789",
+			}),
+			[]byte{
+				0x67, 0x00, 0x35, 0x00, 0x09, 0x20, 0x01,
+				// Kc
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				// Triplet
+				0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+				0x33, 0x33, 0x33, 0x33,
+				0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+				// DRX parameter
+				0x0a, 0x0b,
+				// MS Network Capability
+				0x02, 0xe5, 0xe0,
+				// MEI
+				0x08, 0x21, 0x43, 0x05, 0x21, 0x43, 0x65, 0x87, 0xf9,
+			},
+		}, {
+			"MMContextEPSSecurityContextQuadrupletsAndQuintuplets",
+			ie.NewMMContextEPSSecurityContextQuadrupletsAndQuintuplets(&ie.MMContextFields{
+				KSI:              1,
+				UsedCipher:       1,
+				UsedNASIntegrity: 2,
+				NASDownlinkCount: 0x000102,
+				NASUplinkCount:   0x000304,
+				KASME: []byte{
+					0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+					0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				},
+				Quadruplets: []*ie.AuthenticationQuadruplet{
+					ie.NewAuthenticationQuadruplet(
+						[]byte{0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22},
+						[]byte{0x33, 0x33, 0x33, 0x33},
+						[]byte{0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44},
+						[]byte{
+							0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55,
+							0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55,
+						},
+					),
+				},
+				SubscribedUEAMBR:    ie.NewAggregateMaximumBitRateFields(0x11111111, 0x22222222),
+				UENetworkCapability: []byte{0xf0, 0xf0},
+				AdditionalOctets:    []byte{0x00},
+			}),
+			[]byte{
+				0x6b, 0x00, 0x7d, 0x00, 0x81, 0x04, 0xa1,
+				// NAS Downlink/Uplink Count
+				0x00, 0x01, 0x02, 0x00, 0x03, 0x04,
+				// K_ASME
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				// Quadruplet
+				0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+				0x04, 0x33, 0x33, 0x33, 0x33,
+				0x10, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+				0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55,
+				0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55,
+				// Subscribed UE AMBR
+				0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22,
+				// UE/MS Network Capability, MEI
+				0x02, 0xf0, 0xf0, 0x00, 0x00,
+				// Access restriction data
+				0x00,
+			},
+		}, {
+			"PDNConnection",
+			ie.NewPDNConnection(ie.NewAccessPointName("some.apn.example"), ie.NewEPSBearerID(5)),
+			[]byte{
+				0x6d, 0x00, 0x1a, 0x00,
+				0x47, 0x00, 0x11, 0x00, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+				0x49, 0x00, 0x01, 0x00, 0x05,
+			},
 		}, {
 			"PacketTMSI",
 			ie.NewPacketTMSI(0xdeadbeef),
@@ -601,6 +708,10 @@ func TestIEs(t *testing.T) {
 			"SelectionMode",
 			ie.NewSelectionMode(gtpv2.SelectionModeMSProvidedAPNSubscriptionNotVerified),
 			[]byte{0x80, 0x00, 0x01, 0x00, 0x01},
+		}, {
+			"ChangeReportingAction",
+			ie.NewChangeReportingAction(gtpv2.ChangeReportingActionStartReportingTAIAndECGI),
+			[]byte{0x83, 0x00, 0x01, 0x00, 0x06},
 		}, {
 			"FullyQualifiedCSID/v4",
 			ie.NewFullyQualifiedCSID("1.1.1.1", 1),
@@ -625,6 +736,44 @@ func TestIEs(t *testing.T) {
 			"FullyQualifiedDomainName",
 			ie.NewFullyQualifiedDomainName("some-fqdn.example"),
 			[]byte{0x88, 0x00, 0x12, 0x00, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x66, 0x71, 0x64, 0x6e, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65},
+		}, {
+			"MBMSSessionDuration",
+			ie.NewMBMSSessionDuration(25*time.Hour + 1*time.Second),
+			[]byte{0x8a, 0x00, 0x03, 0x00, 0x07, 0x08, 0x81},
+		}, {
+			"MBMSServiceArea",
+			ie.NewMBMSServiceArea(1, 0xffff),
+			[]byte{0x8b, 0x00, 0x05, 0x00, 0x01, 0x00, 0x01, 0xff, 0xff},
+		}, {
+			"MBMSSessionIdentifier",
+			ie.NewMBMSSessionIdentifier(1),
+			[]byte{0x8c, 0x00, 0x01, 0x00, 0x01},
+		}, {
+			"MBMSFlowIdentifier",
+			ie.NewMBMSFlowIdentifier(0x1234),
+			[]byte{0x8d, 0x00, 0x02, 0x00, 0x12, 0x34},
+		}, {
+			"MBMSIPMulticastDistribution/v4",
+			ie.NewMBMSIPMulticastDistribution(0x11111111, "239.0.0.1", "10.0.0.1", 0),
+			[]byte{
+				0x8e, 0x00, 0x0f, 0x00, 0x11, 0x11, 0x11, 0x11,
+				0x04, 0xef, 0x00, 0x00, 0x01,
+				0x04, 0x0a, 0x00, 0x00, 0x01,
+				0x00,
+			},
+		}, {
+			"MBMSIPMulticastDistribution/v6",
+			ie.NewMBMSIPMulticastDistribution(0x11111111, "ff0e::1", "2001::1", 1),
+			[]byte{
+				0x8e, 0x00, 0x27, 0x00, 0x11, 0x11, 0x11, 0x11,
+				0x50, 0xff, 0x0e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+				0x50, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+				0x01,
+			},
+		}, {
+			"MBMSDistributionAcknowledge",
+			ie.NewMBMSDistributionAcknowledge(2),
+			[]byte{0x8f, 0x00, 0x01, 0x00, 0x02},
 		}, {
 			"RFSPIndex",
 			ie.NewRFSPIndex(1),
@@ -669,6 +818,18 @@ func TestIEs(t *testing.T) {
 			"EPCTimer",
 			ie.NewEPCTimer(20 * time.Hour),
 			[]byte{0x9c, 0x00, 0x01, 0x00, 0x82},
+		}, {
+			"TMGI",
+			ie.NewTMGI(0x123456, "123", "45"),
+			[]byte{0x9e, 0x00, 0x06, 0x00, 0x12, 0x34, 0x56, 0x21, 0xf3, 0x54},
+		}, {
+			"AdditionalMMContextForSRVCC",
+			ie.NewAdditionalMMContextForSRVCC([]byte{0x57, 0x58, 0xa6}, []byte{0x60, 0x14}, []byte{0x04, 0x02, 0x60, 0x04}),
+			[]byte{0x9f, 0x00, 0x0c, 0x00, 0x03, 0x57, 0x58, 0xa6, 0x02, 0x60, 0x14, 0x04, 0x04, 0x02, 0x60, 0x04},
+		}, {
+			"AdditionalFlagsForSRVCC",
+			ie.NewAdditionalFlagsForSRVCC(1, 1),
+			[]byte{0xa0, 0x00, 0x01, 0x00, 0x03},
 		}, {
 			"ULITimestamp",
 			ie.NewULITimestamp(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
@@ -60,7 +60,7 @@ func (i *IE) LocalMBMSBearerContextRelease() bool {
 	}
 	switch i.Type {
 	case MBMSFlags:
-		return i.Payload[0]&0x02 == 1
+		return i.Payload[0]&0x02 == 0x02
 	default:
 		return false
 	}
@@ -74,7 +74,7 @@ func (i *IE) MBMSSessionReEstablishment() bool {
 	}
 	switch i.Type {
 	case MBMSFlags:
-		return i.Payload[0]&0x01 == 1
+		return i.Payload[0]&0x01 == 0x01
 	default:
 		return false
 	}
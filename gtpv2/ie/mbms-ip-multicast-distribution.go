@@ -0,0 +1,167 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+)
+
+// NewMBMSIPMulticastDistribution creates a new MBMSIPMulticastDistribution IE.
+func NewMBMSIPMulticastDistribution(cteid uint32, distAddr, srcAddr string, hcIndicator uint8) *IE {
+	v := NewMBMSIPMulticastDistributionFields(cteid, net.ParseIP(distAddr), net.ParseIP(srcAddr), hcIndicator)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(MBMSIPMulticastDistribution, 0x00, b)
+}
+
+// MBMSIPMulticastDistribution returns MBMSIPMulticastDistribution in
+// MBMSIPMulticastDistributionFields type if the type of IE matches.
+func (i *IE) MBMSIPMulticastDistribution() (*MBMSIPMulticastDistributionFields, error) {
+	if i.Type != MBMSIPMulticastDistribution {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return ParseMBMSIPMulticastDistributionFields(i.Payload)
+}
+
+// CommonTEID returns Common Tunnel Endpoint Identifier in uint32 if the type of IE matches.
+func (i *IE) CommonTEID() (uint32, error) {
+	if i.Type != MBMSIPMulticastDistribution {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return binary.BigEndian.Uint32(i.Payload[0:4]), nil
+}
+
+// MustCommonTEID returns Common Tunnel Endpoint Identifier in uint32, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustCommonTEID() uint32 {
+	v, _ := i.CommonTEID()
+	return v
+}
+
+// MBMSIPMulticastDistributionFields is a set of fields in MBMSIPMulticastDistribution IE.
+type MBMSIPMulticastDistributionFields struct {
+	CommonTEID                     uint32
+	DistributionAddressType        uint8 // 2-bit
+	DistributionAddressLength      uint8 // 6-bit
+	IPMulticastDistributionAddress net.IP
+	SourceAddressType              uint8 // 2-bit
+	SourceAddressLength            uint8 // 6-bit
+	IPMulticastSourceAddress       net.IP
+	MBMSHCIndicator                uint8
+}
+
+// NewMBMSIPMulticastDistributionFields creates a new MBMSIPMulticastDistributionFields.
+func NewMBMSIPMulticastDistributionFields(cteid uint32, distAddr, srcAddr net.IP, hcIndicator uint8) *MBMSIPMulticastDistributionFields {
+	f := &MBMSIPMulticastDistributionFields{
+		CommonTEID:      cteid,
+		MBMSHCIndicator: hcIndicator,
+	}
+
+	f.DistributionAddressType, f.IPMulticastDistributionAddress = mbmsAddressTypeAndIP(distAddr)
+	f.DistributionAddressLength = uint8(len(f.IPMulticastDistributionAddress))
+	f.SourceAddressType, f.IPMulticastSourceAddress = mbmsAddressTypeAndIP(srcAddr)
+	f.SourceAddressLength = uint8(len(f.IPMulticastSourceAddress))
+
+	return f
+}
+
+// mbmsAddressTypeAndIP returns the Address Type and the IP address in the length
+// that corresponds to the type.
+func mbmsAddressTypeAndIP(ip net.IP) (uint8, net.IP) {
+	if v4 := ip.To4(); v4 != nil {
+		return 0, v4
+	}
+	return 1, ip.To16()
+}
+
+// Marshal serializes MBMSIPMulticastDistributionFields.
+func (f *MBMSIPMulticastDistributionFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes MBMSIPMulticastDistributionFields.
+func (f *MBMSIPMulticastDistributionFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	binary.BigEndian.PutUint32(b[0:4], f.CommonTEID)
+	offset := 4
+
+	b[offset] = (f.DistributionAddressType&0x03)<<6 | f.DistributionAddressLength&0x3f
+	copy(b[offset+1:], f.IPMulticastDistributionAddress)
+	offset += 1 + len(f.IPMulticastDistributionAddress)
+
+	b[offset] = (f.SourceAddressType&0x03)<<6 | f.SourceAddressLength&0x3f
+	copy(b[offset+1:], f.IPMulticastSourceAddress)
+	offset += 1 + len(f.IPMulticastSourceAddress)
+
+	b[offset] = f.MBMSHCIndicator
+	return nil
+}
+
+// ParseMBMSIPMulticastDistributionFields decodes MBMSIPMulticastDistributionFields.
+func ParseMBMSIPMulticastDistributionFields(b []byte) (*MBMSIPMulticastDistributionFields, error) {
+	f := &MBMSIPMulticastDistributionFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into MBMSIPMulticastDistributionFields.
+func (f *MBMSIPMulticastDistributionFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 5 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.CommonTEID = binary.BigEndian.Uint32(b[0:4])
+	offset := 4
+
+	f.DistributionAddressType = b[offset] >> 6
+	f.DistributionAddressLength = b[offset] & 0x3f
+	offset++
+	n := int(f.DistributionAddressLength)
+	if l < offset+n+1 {
+		return io.ErrUnexpectedEOF
+	}
+	f.IPMulticastDistributionAddress = net.IP(b[offset : offset+n])
+	offset += n
+
+	f.SourceAddressType = b[offset] >> 6
+	f.SourceAddressLength = b[offset] & 0x3f
+	offset++
+	n = int(f.SourceAddressLength)
+	if l < offset+n+1 {
+		return io.ErrUnexpectedEOF
+	}
+	f.IPMulticastSourceAddress = net.IP(b[offset : offset+n])
+	offset += n
+
+	f.MBMSHCIndicator = b[offset]
+	return nil
+}
+
+// MarshalLen returns the serial length of MBMSIPMulticastDistributionFields in int.
+func (f *MBMSIPMulticastDistributionFields) MarshalLen() int {
+	return 4 + 1 + len(f.IPMulticastDistributionAddress) + 1 + len(f.IPMulticastSourceAddress) + 1
+}
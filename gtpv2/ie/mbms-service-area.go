@@ -0,0 +1,54 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewMBMSServiceArea creates a new MBMSServiceArea IE.
+//
+// At least one and at most 256 MBMS Service Area Codes should be given.
+func NewMBMSServiceArea(codes ...uint16) *IE {
+	if len(codes) < 1 || len(codes) > 256 {
+		return nil
+	}
+
+	i := New(MBMSServiceArea, 0x00, make([]byte, 1+len(codes)*2))
+	i.Payload[0] = uint8(len(codes) - 1)
+	for n, code := range codes {
+		binary.BigEndian.PutUint16(i.Payload[1+n*2:3+n*2], code)
+	}
+	return i
+}
+
+// MBMSServiceArea returns the MBMS Service Area Codes in []uint16 if the type of IE matches.
+func (i *IE) MBMSServiceArea() ([]uint16, error) {
+	if i.Type != MBMSServiceArea {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	n := int(i.Payload[0]) + 1
+	if len(i.Payload) < 1+n*2 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	codes := make([]uint16, n)
+	for x := range codes {
+		codes[x] = binary.BigEndian.Uint16(i.Payload[1+x*2 : 3+x*2])
+	}
+	return codes, nil
+}
+
+// MustMBMSServiceArea returns the MBMS Service Area Codes in []uint16, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSServiceArea() []uint16 {
+	v, _ := i.MBMSServiceArea()
+	return v
+}
@@ -0,0 +1,44 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"time"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewMBMSSessionDuration creates a new MBMSSessionDuration IE.
+//
+// The duration is encoded in days and seconds, and the fraction of a second is
+// truncated.
+func NewMBMSSessionDuration(duration time.Duration) *IE {
+	day := 24 * time.Hour
+	days := uint32(duration / day)
+	secs := uint32((duration % day) / time.Second)
+
+	return New(MBMSSessionDuration, 0x00, utils.Uint32To24((secs&0x1ffff)<<7|days&0x7f))
+}
+
+// MBMSSessionDuration returns MBMSSessionDuration in time.Duration if the type of IE matches.
+func (i *IE) MBMSSessionDuration() (time.Duration, error) {
+	if i.Type != MBMSSessionDuration {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 3 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	v := utils.Uint24To32(i.Payload[0:3])
+	return time.Duration(v&0x7f)*24*time.Hour + time.Duration(v>>7)*time.Second, nil
+}
+
+// MustMBMSSessionDuration returns MBMSSessionDuration in time.Duration, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSSessionDuration() time.Duration {
+	v, _ := i.MBMSSessionDuration()
+	return v
+}
@@ -0,0 +1,762 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"strings"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// Security Mode definitions.
+const (
+	SecurityModeGSMKeyAndTriplets uint8 = iota
+	SecurityModeUMTSKeyUsedCipherAndQuintuplets
+	SecurityModeGSMKeyUsedCipherAndQuintuplets
+	SecurityModeUMTSKeyAndQuintuplets
+	SecurityModeEPSSecurityContextAndQuadruplets
+	SecurityModeUMTSKeyQuadrupletsAndQuintuplets
+)
+
+// NewMMContextGSMKeyAndTriplets creates a new MMContextGSMKeyAndTriplets IE.
+func NewMMContextGSMKeyAndTriplets(f *MMContextFields) *IE {
+	return newMMContextIE(MMContextGSMKeyAndTriplets, SecurityModeGSMKeyAndTriplets, f)
+}
+
+// NewMMContextUMTSKeyUsedCipherAndQuintuplets creates a new MMContextUMTSKeyUsedCipherAndQuintuplets IE.
+func NewMMContextUMTSKeyUsedCipherAndQuintuplets(f *MMContextFields) *IE {
+	return newMMContextIE(MMContextUMTSKeyUsedCipherAndQuintuplets, SecurityModeUMTSKeyUsedCipherAndQuintuplets, f)
+}
+
+// NewMMContextGSMKeyUsedCipherAndQuintuplets creates a new MMContextGSMKeyUsedCipherAndQuintuplets IE.
+func NewMMContextGSMKeyUsedCipherAndQuintuplets(f *MMContextFields) *IE {
+	return newMMContextIE(MMContextGSMKeyUsedCipherAndQuintuplets, SecurityModeGSMKeyUsedCipherAndQuintuplets, f)
+}
+
+// NewMMContextUMTSKeyAndQuintuplets creates a new MMContextUMTSKeyAndQuintuplets IE.
+func NewMMContextUMTSKeyAndQuintuplets(f *MMContextFields) *IE {
+	return newMMContextIE(MMContextUMTSKeyAndQuintuplets, SecurityModeUMTSKeyAndQuintuplets, f)
+}
+
+// NewMMContextEPSSecurityContextQuadrupletsAndQuintuplets creates a new MMContextEPSSecurityContextQuadrupletsAndQuintuplets IE.
+func NewMMContextEPSSecurityContextQuadrupletsAndQuintuplets(f *MMContextFields) *IE {
+	return newMMContextIE(MMContextEPSSecurityContextQuadrupletsAndQuintuplets, SecurityModeEPSSecurityContextAndQuadruplets, f)
+}
+
+// NewMMContextUMTSKeyQuadrupletsAndQuintuplets creates a new MMContextUMTSKeyQuadrupletsAndQuintuplets IE.
+func NewMMContextUMTSKeyQuadrupletsAndQuintuplets(f *MMContextFields) *IE {
+	return newMMContextIE(MMContextUMTSKeyQuadrupletsAndQuintuplets, SecurityModeUMTSKeyQuadrupletsAndQuintuplets, f)
+}
+
+func newMMContextIE(itype, mode uint8, f *MMContextFields) *IE {
+	if f == nil {
+		return nil
+	}
+
+	f.SecurityMode = mode
+	b, err := f.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(itype, 0x00, b)
+}
+
+// MMContext returns MMContext in MMContextFields type if the type of IE matches.
+//
+// This can be used for any of the six types of MM Context IE.
+func (i *IE) MMContext() (*MMContextFields, error) {
+	switch i.Type {
+	case MMContextGSMKeyAndTriplets,
+		MMContextUMTSKeyUsedCipherAndQuintuplets,
+		MMContextGSMKeyUsedCipherAndQuintuplets,
+		MMContextUMTSKeyAndQuintuplets,
+		MMContextEPSSecurityContextQuadrupletsAndQuintuplets,
+		MMContextUMTSKeyQuadrupletsAndQuintuplets:
+		return ParseMMContextFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MMContextFields is a set of fields in MM Context IEs.
+//
+// SecurityMode determines the layout of the fields, and the fields that are not
+// used in the Security Mode are ignored when serializing. Optional fields such as
+// DRXParameter, NH and UE-AMBRs are omitted if they are nil.
+//
+// The octets after MEI (Access restriction data, Old EPS Security Context, Voice
+// Domain Preference, etc.) are not decoded, and kept in AdditionalOctets as they are.
+type MMContextFields struct {
+	SecurityMode uint8
+	// KSI is CKSN, KSI or KSI_ASME depending on SecurityMode.
+	KSI uint8
+	// UsedCipher is Used Cipher, or Used NAS Cipher in EPS Security Context.
+	UsedCipher uint8
+	// UsedNASIntegrity is Used NAS integrity protection algorithm, only in EPS Security Context.
+	UsedNASIntegrity uint8
+	// OSCI is Old Security Context Indicator, only in EPS Security Context.
+	// The Old EPS Security Context should be put in AdditionalOctets if this is set.
+	OSCI bool
+
+	NASDownlinkCount uint32 // 24-bit, only in EPS Security Context
+	NASUplinkCount   uint32 // 24-bit, only in EPS Security Context
+
+	Kc    []byte // 8 octets, in GSM Key
+	CK    []byte // 16 octets, in UMTS Key
+	IK    []byte // 16 octets, in UMTS Key
+	KASME []byte // 32 octets, in EPS Security Context
+
+	Triplets    []*AuthenticationTriplet
+	Quadruplets []*AuthenticationQuadruplet
+	Quintuplets []*AuthenticationQuintuplet
+
+	DRXParameter []byte // 2 octets
+
+	NH  []byte // 32 octets, only in EPS Security Context
+	NCC uint8  // only in EPS Security Context, present if NH is set
+
+	SubscribedUEAMBR *AggregateMaximumBitRateFields
+	UsedUEAMBR       *AggregateMaximumBitRateFields
+
+	UENetworkCapability []byte // not in GSM Key nor in UMTS Key and Used Cipher
+	MSNetworkCapability []byte
+	MEI                 string
+
+	AdditionalOctets []byte
+}
+
+func (f *MMContextFields) hasGSMKey() bool {
+	return f.SecurityMode == SecurityModeGSMKeyAndTriplets ||
+		f.SecurityMode == SecurityModeGSMKeyUsedCipherAndQuintuplets
+}
+
+func (f *MMContextFields) hasUMTSKey() bool {
+	return f.SecurityMode == SecurityModeUMTSKeyUsedCipherAndQuintuplets ||
+		f.SecurityMode == SecurityModeUMTSKeyAndQuintuplets ||
+		f.SecurityMode == SecurityModeUMTSKeyQuadrupletsAndQuintuplets
+}
+
+func (f *MMContextFields) isEPSSecurityContext() bool {
+	return f.SecurityMode == SecurityModeEPSSecurityContextAndQuadruplets
+}
+
+func (f *MMContextFields) hasTriplets() bool {
+	return f.SecurityMode == SecurityModeGSMKeyAndTriplets
+}
+
+func (f *MMContextFields) hasQuadruplets() bool {
+	return f.SecurityMode == SecurityModeEPSSecurityContextAndQuadruplets ||
+		f.SecurityMode == SecurityModeUMTSKeyQuadrupletsAndQuintuplets
+}
+
+func (f *MMContextFields) hasQuintuplets() bool {
+	return f.SecurityMode != SecurityModeGSMKeyAndTriplets
+}
+
+func (f *MMContextFields) hasUENetworkCapability() bool {
+	return f.SecurityMode == SecurityModeUMTSKeyAndQuintuplets ||
+		f.SecurityMode == SecurityModeEPSSecurityContextAndQuadruplets ||
+		f.SecurityMode == SecurityModeUMTSKeyQuadrupletsAndQuintuplets
+}
+
+// Marshal serializes MMContextFields.
+func (f *MMContextFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes MMContextFields.
+func (f *MMContextFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+	if len(f.Triplets) > 7 || len(f.Quadruplets) > 7 || len(f.Quintuplets) > 7 {
+		return ErrMalformed
+	}
+
+	b[0] = f.SecurityMode<<5 | f.KSI&0x07
+	if f.DRXParameter != nil {
+		b[0] |= 0x08
+	}
+	if f.isEPSSecurityContext() && f.NH != nil {
+		b[0] |= 0x10
+	}
+
+	if f.hasTriplets() {
+		b[1] = uint8(len(f.Triplets)) << 5
+	} else {
+		b[1] = uint8(len(f.Quintuplets)) << 5
+	}
+	if f.hasQuadruplets() {
+		b[1] |= uint8(len(f.Quadruplets)) << 2
+	}
+	if f.UsedUEAMBR != nil {
+		b[1] |= 0x02
+	}
+
+	switch f.SecurityMode {
+	case SecurityModeEPSSecurityContextAndQuadruplets:
+		if f.OSCI {
+			b[1] |= 0x01
+		}
+		b[2] = (f.UsedNASIntegrity&0x07)<<4 | f.UsedCipher&0x0f
+		if f.SubscribedUEAMBR != nil {
+			b[2] |= 0x80
+		}
+	case SecurityModeUMTSKeyAndQuintuplets, SecurityModeUMTSKeyQuadrupletsAndQuintuplets:
+		if f.SubscribedUEAMBR != nil {
+			b[1] |= 0x01
+		}
+	default:
+		if f.SubscribedUEAMBR != nil {
+			b[1] |= 0x01
+		}
+		b[2] = f.UsedCipher & 0x07
+	}
+	offset := 3
+
+	switch {
+	case f.isEPSSecurityContext():
+		copy(b[offset:offset+3], utils.Uint32To24(f.NASDownlinkCount))
+		copy(b[offset+3:offset+6], utils.Uint32To24(f.NASUplinkCount))
+		copy(b[offset+6:offset+38], f.KASME)
+		offset += 38
+	case f.hasGSMKey():
+		copy(b[offset:offset+8], f.Kc)
+		offset += 8
+	case f.hasUMTSKey():
+		copy(b[offset:offset+16], f.CK)
+		copy(b[offset+16:offset+32], f.IK)
+		offset += 32
+	}
+
+	if f.hasTriplets() {
+		for _, t := range f.Triplets {
+			if err := t.MarshalTo(b[offset:]); err != nil {
+				return err
+			}
+			offset += t.MarshalLen()
+		}
+	}
+	if f.hasQuadruplets() {
+		for _, q := range f.Quadruplets {
+			if err := q.MarshalTo(b[offset:]); err != nil {
+				return err
+			}
+			offset += q.MarshalLen()
+		}
+	}
+	if f.hasQuintuplets() {
+		for _, q := range f.Quintuplets {
+			if err := q.MarshalTo(b[offset:]); err != nil {
+				return err
+			}
+			offset += q.MarshalLen()
+		}
+	}
+
+	if f.DRXParameter != nil {
+		copy(b[offset:offset+2], f.DRXParameter)
+		offset += 2
+	}
+
+	if f.isEPSSecurityContext() && f.NH != nil {
+		copy(b[offset:offset+32], f.NH)
+		b[offset+32] = f.NCC & 0x07
+		offset += 33
+	}
+
+	if a := f.SubscribedUEAMBR; a != nil {
+		if err := a.MarshalTo(b[offset : offset+8]); err != nil {
+			return err
+		}
+		offset += 8
+	}
+	if a := f.UsedUEAMBR; a != nil {
+		if err := a.MarshalTo(b[offset : offset+8]); err != nil {
+			return err
+		}
+		offset += 8
+	}
+
+	if f.hasUENetworkCapability() {
+		b[offset] = uint8(len(f.UENetworkCapability))
+		copy(b[offset+1:], f.UENetworkCapability)
+		offset += 1 + len(f.UENetworkCapability)
+	}
+
+	b[offset] = uint8(len(f.MSNetworkCapability))
+	copy(b[offset+1:], f.MSNetworkCapability)
+	offset += 1 + len(f.MSNetworkCapability)
+
+	mei, err := f.encodedMEI()
+	if err != nil {
+		return err
+	}
+	b[offset] = uint8(len(mei))
+	copy(b[offset+1:], mei)
+	offset += 1 + len(mei)
+
+	copy(b[offset:], f.AdditionalOctets)
+	return nil
+}
+
+func (f *MMContextFields) encodedMEI() ([]byte, error) {
+	if f.MEI == "" {
+		return nil, nil
+	}
+	return utils.StrToSwappedBytes(f.MEI, "f")
+}
+
+// ParseMMContextFields decodes MMContextFields.
+func ParseMMContextFields(b []byte) (*MMContextFields, error) {
+	f := &MMContextFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into MMContextFields.
+func (f *MMContextFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 3 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.SecurityMode = b[0] >> 5
+	f.KSI = b[0] & 0x07
+	hasDRX := has4thBit(b[0])
+	hasNH := f.isEPSSecurityContext() && has5thBit(b[0])
+
+	var nTriplets, nQuadruplets, nQuintuplets int
+	if f.hasTriplets() {
+		nTriplets = int(b[1] >> 5)
+	} else {
+		nQuintuplets = int(b[1] >> 5)
+	}
+	if f.hasQuadruplets() {
+		nQuadruplets = int(b[1] >> 2 & 0x07)
+	}
+	hasUsedAMBR := has2ndBit(b[1])
+
+	var hasSubscribedAMBR bool
+	switch f.SecurityMode {
+	case SecurityModeEPSSecurityContextAndQuadruplets:
+		f.OSCI = has1stBit(b[1])
+		hasSubscribedAMBR = has8thBit(b[2])
+		f.UsedNASIntegrity = b[2] >> 4 & 0x07
+		f.UsedCipher = b[2] & 0x0f
+	case SecurityModeUMTSKeyAndQuintuplets, SecurityModeUMTSKeyQuadrupletsAndQuintuplets:
+		hasSubscribedAMBR = has1stBit(b[1])
+	default:
+		hasSubscribedAMBR = has1stBit(b[1])
+		f.UsedCipher = b[2] & 0x07
+	}
+	offset := 3
+
+	switch {
+	case f.isEPSSecurityContext():
+		if l < offset+38 {
+			return io.ErrUnexpectedEOF
+		}
+		f.NASDownlinkCount = utils.Uint24To32(b[offset : offset+3])
+		f.NASUplinkCount = utils.Uint24To32(b[offset+3 : offset+6])
+		f.KASME = b[offset+6 : offset+38]
+		offset += 38
+	case f.hasGSMKey():
+		if l < offset+8 {
+			return io.ErrUnexpectedEOF
+		}
+		f.Kc = b[offset : offset+8]
+		offset += 8
+	case f.hasUMTSKey():
+		if l < offset+32 {
+			return io.ErrUnexpectedEOF
+		}
+		f.CK = b[offset : offset+16]
+		f.IK = b[offset+16 : offset+32]
+		offset += 32
+	}
+
+	for n := 0; n < nTriplets; n++ {
+		t, err := ParseAuthenticationTriplet(b[offset:])
+		if err != nil {
+			return err
+		}
+		f.Triplets = append(f.Triplets, t)
+		offset += t.MarshalLen()
+	}
+	for n := 0; n < nQuadruplets; n++ {
+		q, err := ParseAuthenticationQuadruplet(b[offset:])
+		if err != nil {
+			return err
+		}
+		f.Quadruplets = append(f.Quadruplets, q)
+		offset += q.MarshalLen()
+	}
+	for n := 0; n < nQuintuplets; n++ {
+		q, err := ParseAuthenticationQuintuplet(b[offset:])
+		if err != nil {
+			return err
+		}
+		f.Quintuplets = append(f.Quintuplets, q)
+		offset += q.MarshalLen()
+	}
+
+	if hasDRX {
+		if l < offset+2 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DRXParameter = b[offset : offset+2]
+		offset += 2
+	}
+
+	if hasNH {
+		if l < offset+33 {
+			return io.ErrUnexpectedEOF
+		}
+		f.NH = b[offset : offset+32]
+		f.NCC = b[offset+32] & 0x07
+		offset += 33
+	}
+
+	if hasSubscribedAMBR {
+		a, err := ParseAggregateMaximumBitRateFields(b[offset:])
+		if err != nil {
+			return err
+		}
+		f.SubscribedUEAMBR = a
+		offset += 8
+	}
+	if hasUsedAMBR {
+		a, err := ParseAggregateMaximumBitRateFields(b[offset:])
+		if err != nil {
+			return err
+		}
+		f.UsedUEAMBR = a
+		offset += 8
+	}
+
+	var err error
+	if f.hasUENetworkCapability() {
+		f.UENetworkCapability, offset, err = decodeLengthValue(b, offset)
+		if err != nil {
+			return err
+		}
+	}
+
+	f.MSNetworkCapability, offset, err = decodeLengthValue(b, offset)
+	if err != nil {
+		return err
+	}
+
+	mei, offset, err := decodeLengthValue(b, offset)
+	if err != nil {
+		return err
+	}
+	if len(mei) > 0 {
+		f.MEI = strings.TrimSuffix(utils.SwappedBytesToStr(mei, false), "f")
+	}
+
+	if offset < l {
+		f.AdditionalOctets = b[offset:]
+	}
+	return nil
+}
+
+// MarshalLen returns the serial length of MMContextFields in int.
+func (f *MMContextFields) MarshalLen() int {
+	l := 3
+
+	switch {
+	case f.isEPSSecurityContext():
+		l += 38
+	case f.hasGSMKey():
+		l += 8
+	case f.hasUMTSKey():
+		l += 32
+	}
+
+	if f.hasTriplets() {
+		for _, t := range f.Triplets {
+			l += t.MarshalLen()
+		}
+	}
+	if f.hasQuadruplets() {
+		for _, q := range f.Quadruplets {
+			l += q.MarshalLen()
+		}
+	}
+	if f.hasQuintuplets() {
+		for _, q := range f.Quintuplets {
+			l += q.MarshalLen()
+		}
+	}
+
+	if f.DRXParameter != nil {
+		l += 2
+	}
+	if f.isEPSSecurityContext() && f.NH != nil {
+		l += 33
+	}
+	if f.SubscribedUEAMBR != nil {
+		l += 8
+	}
+	if f.UsedUEAMBR != nil {
+		l += 8
+	}
+
+	if f.hasUENetworkCapability() {
+		l += 1 + len(f.UENetworkCapability)
+	}
+	l += 1 + len(f.MSNetworkCapability)
+
+	// MEI is encoded in TBCD, which has two digits in an octet.
+	l += 1 + (len(f.MEI)+1)/2
+
+	return l + len(f.AdditionalOctets)
+}
+
+// decodeLengthValue decodes the value that is preceded by the length in one octet,
+// and returns the value and the offset after the value.
+func decodeLengthValue(b []byte, offset int) ([]byte, int, error) {
+	if len(b) <= offset {
+		return nil, offset, io.ErrUnexpectedEOF
+	}
+	n := int(b[offset])
+	offset++
+	if len(b) < offset+n {
+		return nil, offset, io.ErrUnexpectedEOF
+	}
+	if n == 0 {
+		return nil, offset, nil
+	}
+
+	return b[offset : offset+n], offset + n, nil
+}
+
+// AuthenticationTriplet is an Authentication Triplet in MM Context IE.
+type AuthenticationTriplet struct {
+	RAND []byte // 16 octets
+	SRES []byte // 4 octets
+	Kc   []byte // 8 octets
+}
+
+// NewAuthenticationTriplet creates a new AuthenticationTriplet.
+func NewAuthenticationTriplet(rand, sres, kc []byte) *AuthenticationTriplet {
+	return &AuthenticationTriplet{
+		RAND: rand,
+		SRES: sres,
+		Kc:   kc,
+	}
+}
+
+// MarshalTo serializes AuthenticationTriplet.
+func (t *AuthenticationTriplet) MarshalTo(b []byte) error {
+	if len(b) < t.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	copy(b[0:16], t.RAND)
+	copy(b[16:20], t.SRES)
+	copy(b[20:28], t.Kc)
+	return nil
+}
+
+// ParseAuthenticationTriplet decodes AuthenticationTriplet.
+func ParseAuthenticationTriplet(b []byte) (*AuthenticationTriplet, error) {
+	t := &AuthenticationTriplet{}
+	if err := t.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// UnmarshalBinary decodes given bytes into AuthenticationTriplet.
+func (t *AuthenticationTriplet) UnmarshalBinary(b []byte) error {
+	if len(b) < 28 {
+		return io.ErrUnexpectedEOF
+	}
+
+	t.RAND = b[0:16]
+	t.SRES = b[16:20]
+	t.Kc = b[20:28]
+	return nil
+}
+
+// MarshalLen returns the serial length of AuthenticationTriplet in int.
+func (t *AuthenticationTriplet) MarshalLen() int {
+	return 28
+}
+
+// AuthenticationQuadruplet is an Authentication Quadruplet in MM Context IE.
+type AuthenticationQuadruplet struct {
+	RAND  []byte // 16 octets
+	XRES  []byte
+	AUTN  []byte
+	KASME []byte // 32 octets
+}
+
+// NewAuthenticationQuadruplet creates a new AuthenticationQuadruplet.
+func NewAuthenticationQuadruplet(rand, xres, autn, kasme []byte) *AuthenticationQuadruplet {
+	return &AuthenticationQuadruplet{
+		RAND:  rand,
+		XRES:  xres,
+		AUTN:  autn,
+		KASME: kasme,
+	}
+}
+
+// MarshalTo serializes AuthenticationQuadruplet.
+func (q *AuthenticationQuadruplet) MarshalTo(b []byte) error {
+	if len(b) < q.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	copy(b[0:16], q.RAND)
+	offset := 16
+
+	b[offset] = uint8(len(q.XRES))
+	copy(b[offset+1:], q.XRES)
+	offset += 1 + len(q.XRES)
+
+	b[offset] = uint8(len(q.AUTN))
+	copy(b[offset+1:], q.AUTN)
+	offset += 1 + len(q.AUTN)
+
+	copy(b[offset:offset+32], q.KASME)
+	return nil
+}
+
+// ParseAuthenticationQuadruplet decodes AuthenticationQuadruplet.
+func ParseAuthenticationQuadruplet(b []byte) (*AuthenticationQuadruplet, error) {
+	q := &AuthenticationQuadruplet{}
+	if err := q.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// UnmarshalBinary decodes given bytes into AuthenticationQuadruplet.
+func (q *AuthenticationQuadruplet) UnmarshalBinary(b []byte) error {
+	if len(b) < 16 {
+		return io.ErrUnexpectedEOF
+	}
+	q.RAND = b[0:16]
+
+	var err error
+	offset := 16
+	q.XRES, offset, err = decodeLengthValue(b, offset)
+	if err != nil {
+		return err
+	}
+	q.AUTN, offset, err = decodeLengthValue(b, offset)
+	if err != nil {
+		return err
+	}
+
+	if len(b) < offset+32 {
+		return io.ErrUnexpectedEOF
+	}
+	q.KASME = b[offset : offset+32]
+	return nil
+}
+
+// MarshalLen returns the serial length of AuthenticationQuadruplet in int.
+func (q *AuthenticationQuadruplet) MarshalLen() int {
+	return 16 + 1 + len(q.XRES) + 1 + len(q.AUTN) + 32
+}
+
+// AuthenticationQuintuplet is an Authentication Quintuplet in MM Context IE.
+type AuthenticationQuintuplet struct {
+	RAND []byte // 16 octets
+	XRES []byte
+	CK   []byte // 16 octets
+	IK   []byte // 16 octets
+	AUTN []byte
+}
+
+// NewAuthenticationQuintuplet creates a new AuthenticationQuintuplet.
+func NewAuthenticationQuintuplet(rand, xres, ck, ik, autn []byte) *AuthenticationQuintuplet {
+	return &AuthenticationQuintuplet{
+		RAND: rand,
+		XRES: xres,
+		CK:   ck,
+		IK:   ik,
+		AUTN: autn,
+	}
+}
+
+// MarshalTo serializes AuthenticationQuintuplet.
+func (q *AuthenticationQuintuplet) MarshalTo(b []byte) error {
+	if len(b) < q.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	copy(b[0:16], q.RAND)
+	offset := 16
+
+	b[offset] = uint8(len(q.XRES))
+	copy(b[offset+1:], q.XRES)
+	offset += 1 + len(q.XRES)
+
+	copy(b[offset:offset+16], q.CK)
+	copy(b[offset+16:offset+32], q.IK)
+	offset += 32
+
+	b[offset] = uint8(len(q.AUTN))
+	copy(b[offset+1:], q.AUTN)
+	return nil
+}
+
+// ParseAuthenticationQuintuplet decodes AuthenticationQuintuplet.
+func ParseAuthenticationQuintuplet(b []byte) (*AuthenticationQuintuplet, error) {
+	q := &AuthenticationQuintuplet{}
+	if err := q.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// UnmarshalBinary decodes given bytes into AuthenticationQuintuplet.
+func (q *AuthenticationQuintuplet) UnmarshalBinary(b []byte) error {
+	if len(b) < 16 {
+		return io.ErrUnexpectedEOF
+	}
+	q.RAND = b[0:16]
+
+	var err error
+	offset := 16
+	q.XRES, offset, err = decodeLengthValue(b, offset)
+	if err != nil {
+		return err
+	}
+
+	if len(b) < offset+32 {
+		return io.ErrUnexpectedEOF
+	}
+	q.CK = b[offset : offset+16]
+	q.IK = b[offset+16 : offset+32]
+	offset += 32
+
+	q.AUTN, _, err = decodeLengthValue(b, offset)
+	return err
+}
+
+// MarshalLen returns the serial length of AuthenticationQuintuplet in int.
+func (q *AuthenticationQuintuplet) MarshalLen() int {
+	return 16 + 1 + len(q.XRES) + 32 + 1 + len(q.AUTN)
+}
@@ -0,0 +1,140 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMMContextFields(t *testing.T) {
+	var (
+		rand  = bytes.Repeat([]byte{0x01}, 16)
+		xres  = []byte{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02}
+		autn  = bytes.Repeat([]byte{0x03}, 16)
+		ck    = bytes.Repeat([]byte{0x04}, 16)
+		ik    = bytes.Repeat([]byte{0x05}, 16)
+		kc    = bytes.Repeat([]byte{0x06}, 8)
+		kasme = bytes.Repeat([]byte{0x07}, 32)
+		nh    = bytes.Repeat([]byte{0x08}, 32)
+	)
+
+	cases := []struct {
+		description string
+		constructor func(*MMContextFields) *IE
+		fields      *MMContextFields
+	}{
+		{
+			"GSMKeyAndTriplets",
+			NewMMContextGSMKeyAndTriplets,
+			&MMContextFields{
+				KSI:          1,
+				UsedCipher:   2,
+				Kc:           kc,
+				Triplets:     []*AuthenticationTriplet{NewAuthenticationTriplet(rand, []byte{0x09, 0x09, 0x09, 0x09}, kc)},
+				DRXParameter: []byte{0x0a, 0x0b},
+				UsedUEAMBR:   NewAggregateMaximumBitRateFields(1000, 2000),
+				MEI:          "123450123456789",
+			},
+		}, {
+			"UMTSKeyUsedCipherAndQuintuplets",
+			NewMMContextUMTSKeyUsedCipherAndQuintuplets,
+			&MMContextFields{
+				KSI:                 1,
+				UsedCipher:          2,
+				CK:                  ck,
+				IK:                  ik,
+				Quintuplets:         []*AuthenticationQuintuplet{NewAuthenticationQuintuplet(rand, xres, ck, ik, autn)},
+				SubscribedUEAMBR:    NewAggregateMaximumBitRateFields(1000, 2000),
+				MSNetworkCapability: []byte{0xe5, 0xe0},
+			},
+		}, {
+			"GSMKeyUsedCipherAndQuintuplets",
+			NewMMContextGSMKeyUsedCipherAndQuintuplets,
+			&MMContextFields{
+				KSI:        1,
+				UsedCipher: 2,
+				Kc:         kc,
+				Quintuplets: []*AuthenticationQuintuplet{
+					NewAuthenticationQuintuplet(rand, xres, ck, ik, autn),
+					NewAuthenticationQuintuplet(rand, xres[:4], ck, ik, autn),
+				},
+			},
+		}, {
+			"UMTSKeyAndQuintuplets",
+			NewMMContextUMTSKeyAndQuintuplets,
+			&MMContextFields{
+				KSI:                 1,
+				CK:                  ck,
+				IK:                  ik,
+				Quintuplets:         []*AuthenticationQuintuplet{NewAuthenticationQuintuplet(rand, xres, ck, ik, autn)},
+				UENetworkCapability: []byte{0xf0, 0xf0},
+				MEI:                 "12345012345678",
+				AdditionalOctets:    []byte{0x00},
+			},
+		}, {
+			"EPSSecurityContextQuadrupletsAndQuintuplets",
+			NewMMContextEPSSecurityContextQuadrupletsAndQuintuplets,
+			&MMContextFields{
+				KSI:                 1,
+				UsedCipher:          1,
+				UsedNASIntegrity:    2,
+				NASDownlinkCount:    0x123456,
+				NASUplinkCount:      0x654321,
+				KASME:               kasme,
+				Quadruplets:         []*AuthenticationQuadruplet{NewAuthenticationQuadruplet(rand, xres, autn, kasme)},
+				Quintuplets:         []*AuthenticationQuintuplet{NewAuthenticationQuintuplet(rand, xres, ck, ik, autn)},
+				DRXParameter:        []byte{0x0a, 0x0b},
+				NH:                  nh,
+				NCC:                 3,
+				SubscribedUEAMBR:    NewAggregateMaximumBitRateFields(1000, 2000),
+				UsedUEAMBR:          NewAggregateMaximumBitRateFields(3000, 4000),
+				UENetworkCapability: []byte{0xf0, 0xf0},
+				MSNetworkCapability: []byte{0xe5, 0xe0},
+				MEI:                 "123450123456789",
+				AdditionalOctets:    []byte{0x00},
+			},
+		}, {
+			"UMTSKeyQuadrupletsAndQuintuplets",
+			NewMMContextUMTSKeyQuadrupletsAndQuintuplets,
+			&MMContextFields{
+				KSI:              1,
+				CK:               ck,
+				IK:               ik,
+				Quadruplets:      []*AuthenticationQuadruplet{NewAuthenticationQuadruplet(rand, xres, autn, kasme)},
+				Quintuplets:      []*AuthenticationQuintuplet{NewAuthenticationQuintuplet(rand, xres, ck, ik, autn)},
+				SubscribedUEAMBR: NewAggregateMaximumBitRateFields(1000, 2000),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			i := c.constructor(c.fields)
+			if i == nil {
+				t.Fatal("got nil IE")
+			}
+
+			b, err := i.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := Parse(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := parsed.MMContext()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, c.fields); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewPDNConnection creates a new PDNConnection IE.
+func NewPDNConnection(ies ...*IE) *IE {
+	var omitted []*IE
+	for _, ie := range ies {
+		if ie != nil {
+			omitted = append(omitted, ie)
+		}
+	}
+	return newGroupedIE(PDNConnection, omitted...)
+}
+
+// NewPDNConnectionWithinContextResponse creates a new PDNConnection used within
+// ContextResponse and ForwardRelocationRequest.
+//
+// The IPv4 and IPv6 Addresses should be IPAddress IEs with the instance 0 and 1
+// respectively, which can be set by WithInstance().
+func NewPDNConnectionWithinContextResponse(apn, apnRestriction, selectionMode, ipv4, ipv6, linkedEBI, pgwFTEID, pgwNodeName, ambr, chargingChars, cra *IE, bearerContexts ...*IE) *IE {
+	ies := []*IE{apn, apnRestriction, selectionMode, ipv4, ipv6, linkedEBI, pgwFTEID, pgwNodeName}
+	ies = append(ies, bearerContexts...)
+	ies = append(ies, ambr, chargingChars, cra)
+	return NewPDNConnection(ies...)
+}
+
+// PDNConnection returns the []*IE inside PDNConnection IE.
+func (i *IE) PDNConnection() ([]*IE, error) {
+	if i.Type != PDNConnection {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	ies, err := ParseMultiIEs(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return ies, nil
+}
@@ -0,0 +1,35 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewSCEFPDNConnection creates a new SCEFPDNConnection IE.
+func NewSCEFPDNConnection(ies ...*IE) *IE {
+	var omitted []*IE
+	for _, ie := range ies {
+		if ie != nil {
+			omitted = append(omitted, ie)
+		}
+	}
+	return newGroupedIE(SCEFPDNConnection, omitted...)
+}
+
+// SCEFPDNConnection returns the []*IE inside SCEFPDNConnection IE.
+func (i *IE) SCEFPDNConnection() ([]*IE, error) {
+	if i.Type != SCEFPDNConnection {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	ies, err := ParseMultiIEs(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return ies, nil
+}
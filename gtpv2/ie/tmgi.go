@@ -0,0 +1,43 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewTMGI creates a new TMGI IE.
+func NewTMGI(serviceID uint32, mcc, mnc string) *IE {
+	plmn, err := utils.EncodePLMN(mcc, mnc)
+	if err != nil {
+		return nil
+	}
+
+	i := New(TMGI, 0x00, make([]byte, 6))
+	copy(i.Payload[0:3], utils.Uint32To24(serviceID))
+	copy(i.Payload[3:6], plmn)
+	return i
+}
+
+// MBMSServiceID returns MBMS Service ID in uint32 if the type of IE matches.
+func (i *IE) MBMSServiceID() (uint32, error) {
+	if i.Type != TMGI {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 3 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return utils.Uint24To32(i.Payload[0:3]), nil
+}
+
+// MustMBMSServiceID returns MBMS Service ID in uint32, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSServiceID() uint32 {
+	v, _ := i.MBMSServiceID()
+	return v
+}
@@ -0,0 +1,149 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewTraceInformation creates a new TraceInformation IE.
+//
+// triggeringEvents and listOfInterfaces are the bitmaps defined in TS 32.422,
+// which should be 9 and 12 octets respectively. Shorter values are padded with
+// zeros, and longer ones are truncated.
+func NewTraceInformation(mcc, mnc string, traceID uint32, triggeringEvents []byte, listOfNETypes uint16, sessionTraceDepth uint8, listOfInterfaces []byte, collectionEntity string) *IE {
+	v := NewTraceInformationFields(
+		mcc, mnc, traceID, triggeringEvents, listOfNETypes,
+		sessionTraceDepth, listOfInterfaces, net.ParseIP(collectionEntity),
+	)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(TraceInformation, 0x00, b)
+}
+
+// TraceInformation returns TraceInformation in TraceInformationFields type if the type of IE matches.
+func (i *IE) TraceInformation() (*TraceInformationFields, error) {
+	switch i.Type {
+	case TraceInformation:
+		return ParseTraceInformationFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// TraceInformationFields is a set of fields in TraceInformation IE.
+type TraceInformationFields struct {
+	MCC, MNC                         string
+	TraceID                          uint32 // 24-bit
+	TriggeringEvents                 []byte // 9 octets
+	ListOfNETypes                    uint16
+	SessionTraceDepth                uint8
+	ListOfInterfaces                 []byte // 12 octets
+	IPAddressOfTraceCollectionEntity net.IP
+}
+
+// NewTraceInformationFields creates a new TraceInformationFields.
+func NewTraceInformationFields(mcc, mnc string, traceID uint32, triggeringEvents []byte, listOfNETypes uint16, sessionTraceDepth uint8, listOfInterfaces []byte, collectionEntity net.IP) *TraceInformationFields {
+	f := &TraceInformationFields{
+		MCC:               mcc,
+		MNC:               mnc,
+		TraceID:           traceID,
+		TriggeringEvents:  make([]byte, 9),
+		ListOfNETypes:     listOfNETypes,
+		SessionTraceDepth: sessionTraceDepth,
+		ListOfInterfaces:  make([]byte, 12),
+	}
+	copy(f.TriggeringEvents, triggeringEvents)
+	copy(f.ListOfInterfaces, listOfInterfaces)
+
+	if v4 := collectionEntity.To4(); v4 != nil {
+		f.IPAddressOfTraceCollectionEntity = v4
+	} else {
+		f.IPAddressOfTraceCollectionEntity = collectionEntity
+	}
+
+	return f
+}
+
+// Marshal serializes TraceInformationFields.
+func (f *TraceInformationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes TraceInformationFields.
+func (f *TraceInformationFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	plmn, err := utils.EncodePLMN(f.MCC, f.MNC)
+	if err != nil {
+		return err
+	}
+	copy(b[0:3], plmn)
+	copy(b[3:6], utils.Uint32To24(f.TraceID))
+	copy(b[6:15], f.TriggeringEvents)
+	binary.BigEndian.PutUint16(b[15:17], f.ListOfNETypes)
+	b[17] = f.SessionTraceDepth
+	copy(b[18:30], f.ListOfInterfaces)
+	copy(b[30:], f.IPAddressOfTraceCollectionEntity)
+
+	return nil
+}
+
+// ParseTraceInformationFields decodes TraceInformationFields.
+func ParseTraceInformationFields(b []byte) (*TraceInformationFields, error) {
+	f := &TraceInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into TraceInformationFields.
+func (f *TraceInformationFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 30 {
+		return io.ErrUnexpectedEOF
+	}
+
+	var err error
+	f.MCC, f.MNC, err = utils.DecodePLMN(b[0:3])
+	if err != nil {
+		return err
+	}
+	f.TraceID = utils.Uint24To32(b[3:6])
+	f.TriggeringEvents = b[6:15]
+	f.ListOfNETypes = binary.BigEndian.Uint16(b[15:17])
+	f.SessionTraceDepth = b[17]
+	f.ListOfInterfaces = b[18:30]
+
+	switch len(b[30:]) {
+	case 0:
+	case net.IPv4len, net.IPv6len:
+		f.IPAddressOfTraceCollectionEntity = net.IP(b[30:])
+	default:
+		return ErrMalformed
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of TraceInformationFields in int.
+func (f *TraceInformationFields) MarshalLen() int {
+	return 30 + len(f.IPAddressOfTraceCollectionEntity)
+}
@@ -0,0 +1,164 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// AlertMMEAcknowledge is a AlertMMEAcknowledge Header and its IEs above.
+type AlertMMEAcknowledge struct {
+	*Header
+	Cause            *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewAlertMMEAcknowledge creates a new AlertMMEAcknowledge.
+func NewAlertMMEAcknowledge(teid, seq uint32, ies ...*ie.IE) *AlertMMEAcknowledge {
+	a := &AlertMMEAcknowledge{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeAlertMMEAcknowledge, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			a.Cause = i
+		case ie.PrivateExtension:
+			a.PrivateExtension = i
+		default:
+			a.AdditionalIEs = append(a.AdditionalIEs, i)
+		}
+	}
+
+	a.SetLength()
+	return a
+}
+
+// Marshal serializes AlertMMEAcknowledge into bytes.
+func (a *AlertMMEAcknowledge) Marshal() ([]byte, error) {
+	b := make([]byte, a.MarshalLen())
+	if err := a.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes AlertMMEAcknowledge into bytes.
+func (a *AlertMMEAcknowledge) MarshalTo(b []byte) error {
+	if a.Header.Payload != nil {
+		a.Header.Payload = nil
+	}
+	a.Header.Payload = make([]byte, a.MarshalLen()-a.Header.MarshalLen())
+
+	offset := 0
+	if ie := a.Cause; ie != nil {
+		if err := ie.MarshalTo(a.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := a.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(a.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range a.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(a.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	a.Header.SetLength()
+	return a.Header.MarshalTo(b)
+}
+
+// ParseAlertMMEAcknowledge decodes given bytes as AlertMMEAcknowledge.
+func ParseAlertMMEAcknowledge(b []byte) (*AlertMMEAcknowledge, error) {
+	a := &AlertMMEAcknowledge{}
+	if err := a.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// UnmarshalBinary decodes given bytes as AlertMMEAcknowledge.
+func (a *AlertMMEAcknowledge) UnmarshalBinary(b []byte) error {
+	var err error
+	a.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(a.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(a.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			a.Cause = i
+		case ie.PrivateExtension:
+			a.PrivateExtension = i
+		default:
+			a.AdditionalIEs = append(a.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (a *AlertMMEAcknowledge) MarshalLen() int {
+	l := a.Header.MarshalLen() - len(a.Header.Payload)
+
+	if ie := a.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := a.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range a.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (a *AlertMMEAcknowledge) SetLength() {
+	a.Header.Length = uint16(a.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (a *AlertMMEAcknowledge) MessageTypeName() string {
+	return "Alert MME Acknowledge"
+}
+
+// TEID returns the TEID in uint32.
+func (a *AlertMMEAcknowledge) TEID() uint32 {
+	return a.Header.teid()
+}
@@ -0,0 +1,432 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// BearerResourceCommand is a BearerResourceCommand Header and its IEs above.
+type BearerResourceCommand struct {
+	*Header
+	LinkedEBI                         *ie.IE
+	EBI                               *ie.IE
+	PTI                               *ie.IE
+	FlowQoS                           *ie.IE
+	TAD                               *ie.IE
+	RATType                           *ie.IE
+	ServingNetwork                    *ie.IE
+	ULI                               *ie.IE
+	IndicationFlags                   *ie.IE
+	S4USGSNFTEID                      *ie.IE
+	S12RNCFTEID                       *ie.IE
+	SenderFTEIDC                      *ie.IE
+	PCO                               *ie.IE
+	SignallingPriorityIndication      *ie.IE
+	MMESGSNOverloadControlInformation *ie.IE
+	SGWOverloadControlInformation     *ie.IE
+	NBIFOMContainer                   *ie.IE
+	EPCO                              *ie.IE
+	PrivateExtension                  *ie.IE
+	AdditionalIEs                     []*ie.IE
+}
+
+// NewBearerResourceCommand creates a new BearerResourceCommand.
+func NewBearerResourceCommand(teid, seq uint32, ies ...*ie.IE) *BearerResourceCommand {
+	m := &BearerResourceCommand{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeBearerResourceCommand, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.EPSBearerID:
+			switch i.Instance() {
+			case 0:
+				m.LinkedEBI = i
+			case 1:
+				m.EBI = i
+			default:
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.ProcedureTransactionID:
+			m.PTI = i
+		case ie.FlowQoS:
+			m.FlowQoS = i
+		case ie.TrafficAggregateDescription:
+			m.TAD = i
+		case ie.RATType:
+			m.RATType = i
+		case ie.ServingNetwork:
+			m.ServingNetwork = i
+		case ie.UserLocationInformation:
+			m.ULI = i
+		case ie.Indication:
+			m.IndicationFlags = i
+		case ie.FullyQualifiedTEID:
+			switch i.Instance() {
+			case 0:
+				m.S4USGSNFTEID = i
+			case 1:
+				m.S12RNCFTEID = i
+			case 2:
+				m.SenderFTEIDC = i
+			default:
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.ProtocolConfigurationOptions:
+			m.PCO = i
+		case ie.SignallingPriorityIndication:
+			m.SignallingPriorityIndication = i
+		case ie.OverloadControlInformation:
+			switch i.Instance() {
+			case 0:
+				m.MMESGSNOverloadControlInformation = i
+			case 1:
+				m.SGWOverloadControlInformation = i
+			default:
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.FContainer:
+			m.NBIFOMContainer = i
+		case ie.ExtendedProtocolConfigurationOptions:
+			m.EPCO = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal serializes BearerResourceCommand into bytes.
+func (m *BearerResourceCommand) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes BearerResourceCommand into bytes.
+func (m *BearerResourceCommand) MarshalTo(b []byte) error {
+	if m.Header.Payload != nil {
+		m.Header.Payload = nil
+	}
+	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
+
+	offset := 0
+	if ie := m.LinkedEBI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.EBI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PTI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.FlowQoS; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.TAD; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.RATType; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.ServingNetwork; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.ULI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.IndicationFlags; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.S4USGSNFTEID; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.S12RNCFTEID; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.SenderFTEIDC; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PCO; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.SignallingPriorityIndication; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MMESGSNOverloadControlInformation; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.SGWOverloadControlInformation; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.NBIFOMContainer; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.EPCO; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	m.Header.SetLength()
+	return m.Header.MarshalTo(b)
+}
+
+// ParseBearerResourceCommand decodes given bytes as BearerResourceCommand.
+func ParseBearerResourceCommand(b []byte) (*BearerResourceCommand, error) {
+	m := &BearerResourceCommand{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes given bytes as BearerResourceCommand.
+func (m *BearerResourceCommand) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.EPSBearerID:
+			switch i.Instance() {
+			case 0:
+				m.LinkedEBI = i
+			case 1:
+				m.EBI = i
+			default:
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.ProcedureTransactionID:
+			m.PTI = i
+		case ie.FlowQoS:
+			m.FlowQoS = i
+		case ie.TrafficAggregateDescription:
+			m.TAD = i
+		case ie.RATType:
+			m.RATType = i
+		case ie.ServingNetwork:
+			m.ServingNetwork = i
+		case ie.UserLocationInformation:
+			m.ULI = i
+		case ie.Indication:
+			m.IndicationFlags = i
+		case ie.FullyQualifiedTEID:
+			switch i.Instance() {
+			case 0:
+				m.S4USGSNFTEID = i
+			case 1:
+				m.S12RNCFTEID = i
+			case 2:
+				m.SenderFTEIDC = i
+			default:
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.ProtocolConfigurationOptions:
+			m.PCO = i
+		case ie.SignallingPriorityIndication:
+			m.SignallingPriorityIndication = i
+		case ie.OverloadControlInformation:
+			switch i.Instance() {
+			case 0:
+				m.MMESGSNOverloadControlInformation = i
+			case 1:
+				m.SGWOverloadControlInformation = i
+			default:
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.FContainer:
+			m.NBIFOMContainer = i
+		case ie.ExtendedProtocolConfigurationOptions:
+			m.EPCO = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (m *BearerResourceCommand) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if ie := m.LinkedEBI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.EBI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PTI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.FlowQoS; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.TAD; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.RATType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.ServingNetwork; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.ULI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.IndicationFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.S4USGSNFTEID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.S12RNCFTEID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.SenderFTEIDC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PCO; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.SignallingPriorityIndication; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MMESGSNOverloadControlInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.SGWOverloadControlInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.NBIFOMContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.EPCO; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *BearerResourceCommand) SetLength() {
+	m.Header.Length = uint16(m.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *BearerResourceCommand) MessageTypeName() string {
+	return "Bearer Resource Command"
+}
+
+// TEID returns the TEID in uint32.
+func (m *BearerResourceCommand) TEID() uint32 {
+	return m.Header.teid()
+}
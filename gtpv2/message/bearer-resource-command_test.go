@@ -0,0 +1,63 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestBearerResourceCommand(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewBearerResourceCommand(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewEPSBearerID(5),
+				ie.NewProcedureTransactionID(1),
+				ie.NewFlowQoS(0x09, 0x1111111111, 0x2222222222, 0x1111111111, 0x2222222222),
+				ie.NewTrafficAggregateDescriptionCreateNewTFT(
+					[]*ie.TFTPacketFilter{
+						ie.NewTFTPacketFilter(
+							ie.TFTPFBidirectional, 1, 0,
+							ie.NewTFTPFComponentIPv4RemoteAddress(net.ParseIP("10.10.10.10"), net.IPv4Mask(255, 255, 255, 0)),
+						),
+					}, nil,
+				),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS11MMEGTPC, 0xffffffff, "1.1.1.1", "").WithInstance(2),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x44, 0x00, 0x49, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// LinkedEBI
+				0x49, 0x00, 0x01, 0x00, 0x05,
+				// PTI
+				0x64, 0x00, 0x01, 0x00, 0x01,
+				// FlowQoS
+				0x51, 0x00, 0x15, 0x00, 0x09, 0x11, 0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22, 0x22, 0x11,
+				0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22, 0x22,
+				// TAD
+				0x55, 0x00, 0x0d, 0x00, 0x21, 0x31, 0x00, 0x09, 0x10, 0x0a, 0x0a, 0x0a, 0x0a, 0xff, 0xff, 0xff,
+				0x00,
+				// SenderFTEIDC
+				0x57, 0x00, 0x09, 0x02, 0x8a, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseBearerResourceCommand(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,272 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// BearerResourceFailureIndication is a BearerResourceFailureIndication Header and its IEs above.
+type BearerResourceFailureIndication struct {
+	*Header
+	Cause                         *ie.IE
+	LinkedEBI                     *ie.IE
+	PTI                           *ie.IE
+	IndicationFlags               *ie.IE
+	PGWOverloadControlInformation *ie.IE
+	SGWOverloadControlInformation *ie.IE
+	Recovery                      *ie.IE
+	NBIFOMContainer               *ie.IE
+	PrivateExtension              *ie.IE
+	AdditionalIEs                 []*ie.IE
+}
+
+// NewBearerResourceFailureIndication creates a new BearerResourceFailureIndication.
+func NewBearerResourceFailureIndication(teid, seq uint32, ies ...*ie.IE) *BearerResourceFailureIndication {
+	m := &BearerResourceFailureIndication{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeBearerResourceFailureIndication, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			m.Cause = i
+		case ie.EPSBearerID:
+			m.LinkedEBI = i
+		case ie.ProcedureTransactionID:
+			m.PTI = i
+		case ie.Indication:
+			m.IndicationFlags = i
+		case ie.OverloadControlInformation:
+			switch i.Instance() {
+			case 0:
+				m.PGWOverloadControlInformation = i
+			case 1:
+				m.SGWOverloadControlInformation = i
+			default:
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.Recovery:
+			m.Recovery = i
+		case ie.FContainer:
+			m.NBIFOMContainer = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal serializes BearerResourceFailureIndication into bytes.
+func (m *BearerResourceFailureIndication) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes BearerResourceFailureIndication into bytes.
+func (m *BearerResourceFailureIndication) MarshalTo(b []byte) error {
+	if m.Header.Payload != nil {
+		m.Header.Payload = nil
+	}
+	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
+
+	offset := 0
+	if ie := m.Cause; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.LinkedEBI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PTI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.IndicationFlags; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PGWOverloadControlInformation; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.SGWOverloadControlInformation; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.Recovery; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.NBIFOMContainer; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	m.Header.SetLength()
+	return m.Header.MarshalTo(b)
+}
+
+// ParseBearerResourceFailureIndication decodes given bytes as BearerResourceFailureIndication.
+func ParseBearerResourceFailureIndication(b []byte) (*BearerResourceFailureIndication, error) {
+	m := &BearerResourceFailureIndication{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes given bytes as BearerResourceFailureIndication.
+func (m *BearerResourceFailureIndication) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			m.Cause = i
+		case ie.EPSBearerID:
+			m.LinkedEBI = i
+		case ie.ProcedureTransactionID:
+			m.PTI = i
+		case ie.Indication:
+			m.IndicationFlags = i
+		case ie.OverloadControlInformation:
+			switch i.Instance() {
+			case 0:
+				m.PGWOverloadControlInformation = i
+			case 1:
+				m.SGWOverloadControlInformation = i
+			default:
+				m.AdditionalIEs = append(m.AdditionalIEs, i)
+			}
+		case ie.Recovery:
+			m.Recovery = i
+		case ie.FContainer:
+			m.NBIFOMContainer = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (m *BearerResourceFailureIndication) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if ie := m.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.LinkedEBI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PTI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.IndicationFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PGWOverloadControlInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.SGWOverloadControlInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.Recovery; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.NBIFOMContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *BearerResourceFailureIndication) SetLength() {
+	m.Header.Length = uint16(m.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *BearerResourceFailureIndication) MessageTypeName() string {
+	return "Bearer Resource Failure Indication"
+}
+
+// TEID returns the TEID in uint32.
+func (m *BearerResourceFailureIndication) TEID() uint32 {
+	return m.Header.teid()
+}
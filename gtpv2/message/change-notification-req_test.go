@@ -0,0 +1,51 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestChangeNotificationRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewChangeNotificationRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123451234567890"),
+				ie.NewRATType(gtpv2.RATTypeEUTRAN),
+				ie.NewUserLocationInformationLazy("123", "45", -1, -1, -1, -1, 0x3333, 0x4444, -1, -1),
+				ie.NewEPSBearerID(5),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x26, 0x00, 0x2f, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// IMSI
+				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// RATType
+				0x52, 0x00, 0x01, 0x00, 0x06,
+				// ULI
+				0x56, 0x00, 0x0d, 0x00, 0x18, 0x21, 0xf3, 0x54, 0x33, 0x33, 0x21, 0xf3, 0x54, 0x00, 0x00, 0x44,
+				0x44,
+				// LinkedEBI
+				0x49, 0x00, 0x01, 0x00, 0x05,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseChangeNotificationRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
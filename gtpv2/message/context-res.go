@@ -67,7 +67,7 @@ func NewContextResponse(teid, seq uint32, ies ...*ie.IE) *ContextResponse {
 			ie.MMContextGSMKeyAndTriplets, ie.MMContextGSMKeyUsedCipherAndQuintuplets,
 			ie.MMContextUMTSKeyAndQuintuplets, ie.MMContextUMTSKeyQuadrupletsAndQuintuplets,
 			ie.MMContextUMTSKeyUsedCipherAndQuintuplets:
-			if c.UEMMContext != nil {
+			if c.UEMMContext == nil {
 				c.UEMMContext = i
 			} else {
 				c.AdditionalIEs = append(c.AdditionalIEs, i)
@@ -404,7 +404,7 @@ func (c *ContextResponse) UnmarshalBinary(b []byte) error {
 			ie.MMContextGSMKeyAndTriplets, ie.MMContextGSMKeyUsedCipherAndQuintuplets,
 			ie.MMContextUMTSKeyAndQuintuplets, ie.MMContextUMTSKeyQuadrupletsAndQuintuplets,
 			ie.MMContextUMTSKeyUsedCipherAndQuintuplets:
-			if c.UEMMContext != nil {
+			if c.UEMMContext == nil {
 				c.UEMMContext = i
 			} else {
 				c.AdditionalIEs = append(c.AdditionalIEs, i)
@@ -21,16 +21,58 @@ func TestContextResponse(t *testing.T) {
 				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
 				ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
 				ie.NewIMSI("123451234567890"),
-				// ie.NewMMContext(),  XXX- implement!
+				ie.NewMMContextEPSSecurityContextQuadrupletsAndQuintuplets(&ie.MMContextFields{
+					KSI:              1,
+					UsedCipher:       1,
+					UsedNASIntegrity: 2,
+					NASDownlinkCount: 1,
+					NASUplinkCount:   1,
+					KASME: []byte{
+						0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+						0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+					},
+					MEI:              "123450123456789",
+					AdditionalOctets: []byte{0x00},
+				}),
+				ie.NewPDNConnectionWithinContextResponse(
+					ie.NewAccessPointName("some.apn.example"), nil, nil,
+					ie.NewIPAddress("10.10.10.10"), nil,
+					ie.NewEPSBearerID(5),
+					ie.NewFullyQualifiedTEID(gtpv2.IFTypeS5S8PGWGTPC, 0x11111111, "2.2.2.2", ""), nil,
+					ie.NewAggregateMaximumBitRate(0x11111111, 0x22222222), nil, nil,
+					ie.NewBearerContext(ie.NewEPSBearerID(5)),
+				),
 				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS10MMEGTPC, 0xffffffff, "1.1.1.1", ""),
 			),
 			Serialized: []byte{
 				// Header
-				0x48, 0x83, 0x00, 0x27, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				0x48, 0x83, 0x00, 0xa8, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
 				// Cause
 				0x02, 0x00, 0x02, 0x00, 0x10, 0x00,
 				// IMSI
 				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// MM Context
+				0x6b, 0x00, 0x35, 0x00, 0x81, 0x00, 0x21,
+				0x00, 0x00, 0x01, 0x00, 0x00, 0x01,
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				0x00, 0x00, 0x08, 0x21, 0x43, 0x05, 0x21, 0x43, 0x65, 0x87, 0xf9, 0x00,
+				// PDN Connection
+				0x6d, 0x00, 0x44, 0x00,
+				//   APN
+				0x47, 0x00, 0x11, 0x00, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+				//   IPv4 Address
+				0x4a, 0x00, 0x04, 0x00, 0x0a, 0x0a, 0x0a, 0x0a,
+				//   Linked EBI
+				0x49, 0x00, 0x01, 0x00, 0x05,
+				//   PGW S5/S8 F-TEID
+				0x57, 0x00, 0x09, 0x00, 0x87, 0x11, 0x11, 0x11, 0x11, 0x02, 0x02, 0x02, 0x02,
+				//   Bearer Context
+				0x5d, 0x00, 0x05, 0x00,
+				//     EBI
+				0x49, 0x00, 0x01, 0x00, 0x05,
+				//   APN-AMBR
+				0x48, 0x00, 0x08, 0x00, 0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22,
 				// F-TEID
 				0x57, 0x00, 0x09, 0x00, 0x8c, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
 			},
@@ -0,0 +1,54 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestCreateForwardingTunnelRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewCreateForwardingTunnelRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewS103PDNDataForwardingInfo("1.1.1.1", 0x11111111, 5, 6),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0xa0, 0x00, 0x18, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// S103PDNDataForwardingInfo
+				0x5a, 0x00, 0x0c, 0x00, 0x04, 0x01, 0x01, 0x01, 0x01, 0x11, 0x11, 0x11, 0x11, 0x02, 0x05, 0x06,
+			},
+		}, {
+			Description: "MultiplePDNConnections",
+			Structured: message.NewCreateForwardingTunnelRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewS103PDNDataForwardingInfo("1.1.1.1", 0x11111111, 5, 6),
+				ie.NewS103PDNDataForwardingInfo("1.1.1.2", 0x33333333, 7),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0xa0, 0x00, 0x27, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// S103PDNDataForwardingInfo
+				0x5a, 0x00, 0x0c, 0x00, 0x04, 0x01, 0x01, 0x01, 0x01, 0x11, 0x11, 0x11, 0x11, 0x02, 0x05, 0x06,
+				0x5a, 0x00, 0x0b, 0x00, 0x04, 0x01, 0x01, 0x01, 0x02, 0x33, 0x33, 0x33, 0x33, 0x01, 0x07,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseCreateForwardingTunnelRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,226 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// ForwardAccessContextNotification is a ForwardAccessContextNotification Header and its IEs above.
+type ForwardAccessContextNotification struct {
+	*Header
+	RABContexts                []*ie.IE
+	SourceRNCPDCPContextInfo   *ie.IE
+	PDUNumbers                 *ie.IE
+	EUTRANTransparentContainer *ie.IE
+	IndicationFlags            *ie.IE
+	PrivateExtension           *ie.IE
+	AdditionalIEs              []*ie.IE
+}
+
+// NewForwardAccessContextNotification creates a new ForwardAccessContextNotification.
+func NewForwardAccessContextNotification(teid, seq uint32, ies ...*ie.IE) *ForwardAccessContextNotification {
+	f := &ForwardAccessContextNotification{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeForwardAccessContextNotification, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.RABContext:
+			f.RABContexts = append(f.RABContexts, i)
+		case ie.SourceRNCPDCPContextInfo:
+			f.SourceRNCPDCPContextInfo = i
+		case ie.PDUNumbers:
+			f.PDUNumbers = i
+		case ie.FContainer:
+			f.EUTRANTransparentContainer = i
+		case ie.Indication:
+			f.IndicationFlags = i
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	f.SetLength()
+	return f
+}
+
+// Marshal serializes ForwardAccessContextNotification into bytes.
+func (f *ForwardAccessContextNotification) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes ForwardAccessContextNotification into bytes.
+func (f *ForwardAccessContextNotification) MarshalTo(b []byte) error {
+	if f.Header.Payload != nil {
+		f.Header.Payload = nil
+	}
+	f.Header.Payload = make([]byte, f.MarshalLen()-f.Header.MarshalLen())
+
+	offset := 0
+	for _, ie := range f.RABContexts {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SourceRNCPDCPContextInfo; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PDUNumbers; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.EUTRANTransparentContainer; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.IndicationFlags; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	f.Header.SetLength()
+	return f.Header.MarshalTo(b)
+}
+
+// ParseForwardAccessContextNotification decodes given bytes as ForwardAccessContextNotification.
+func ParseForwardAccessContextNotification(b []byte) (*ForwardAccessContextNotification, error) {
+	f := &ForwardAccessContextNotification{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes as ForwardAccessContextNotification.
+func (f *ForwardAccessContextNotification) UnmarshalBinary(b []byte) error {
+	var err error
+	f.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(f.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(f.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.RABContext:
+			f.RABContexts = append(f.RABContexts, i)
+		case ie.SourceRNCPDCPContextInfo:
+			f.SourceRNCPDCPContextInfo = i
+		case ie.PDUNumbers:
+			f.PDUNumbers = i
+		case ie.FContainer:
+			f.EUTRANTransparentContainer = i
+		case ie.Indication:
+			f.IndicationFlags = i
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (f *ForwardAccessContextNotification) MarshalLen() int {
+	l := f.Header.MarshalLen() - len(f.Header.Payload)
+
+	for _, ie := range f.RABContexts {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	if ie := f.SourceRNCPDCPContextInfo; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PDUNumbers; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.EUTRANTransparentContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.IndicationFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (f *ForwardAccessContextNotification) SetLength() {
+	f.Header.Length = uint16(f.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (f *ForwardAccessContextNotification) MessageTypeName() string {
+	return "Forward Access Context Notification"
+}
+
+// TEID returns the TEID in uint32.
+func (f *ForwardAccessContextNotification) TEID() uint32 {
+	return f.Header.teid()
+}
@@ -0,0 +1,874 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// ForwardRelocationRequest is a ForwardRelocationRequest Header and its IEs above.
+type ForwardRelocationRequest struct {
+	*Header
+	IMSI                               *ie.IE
+	SenderFTEID                        *ie.IE
+	PDNConnections                     []*ie.IE
+	SGWS11S4FTEID                      *ie.IE
+	SGWNodeName                        *ie.IE
+	MMContext                          *ie.IE
+	IndicationFlags                    *ie.IE
+	EUTRANTransparentContainer         *ie.IE
+	UTRANTransparentContainer          *ie.IE
+	BSSContainer                       *ie.IE
+	TargetIdentification               *ie.IE
+	HRPDAccessNodeS101IPAddress        *ie.IE
+	OnexIWSS102IPAddress               *ie.IE
+	S1APCause                          *ie.IE
+	RANAPCause                         *ie.IE
+	BSSGPCause                         *ie.IE
+	SourceIdentification               *ie.IE
+	SelectedPLMNID                     *ie.IE
+	Recovery                           *ie.IE
+	TraceInformation                   *ie.IE
+	SubscribedRFSPIndex                *ie.IE
+	RFSPIndexInUse                     *ie.IE
+	CSGID                              *ie.IE
+	CSGMembershipIndication            *ie.IE
+	UETimeZone                         *ie.IE
+	ServingNetwork                     *ie.IE
+	MMESGSNLDN                         *ie.IE
+	AdditionalMMContextForSRVCC        *ie.IE
+	AdditionalFlagsForSRVCC            *ie.IE
+	STNSR                              *ie.IE
+	CMSISDN                            *ie.IE
+	MDTConfiguration                   *ie.IE
+	SGSNNodeName                       *ie.IE
+	MMENodeName                        *ie.IE
+	UCI                                *ie.IE
+	MonitoringEventInformation         *ie.IE
+	UEUsageType                        *ie.IE
+	SCEFPDNConnections                 []*ie.IE
+	MSISDN                             *ie.IE
+	SourceUDPPortNumber                *ie.IE
+	ServingPLMNRateControl             *ie.IE
+	ExtendedTraceInformation           *ie.IE
+	SubscribedAdditionalRRMPolicyIndex *ie.IE
+	AdditionalRRMPolicyIndexInUse      *ie.IE
+	PrivateExtension                   *ie.IE
+	AdditionalIEs                      []*ie.IE
+}
+
+// NewForwardRelocationRequest creates a new ForwardRelocationRequest.
+func NewForwardRelocationRequest(teid, seq uint32, ies ...*ie.IE) *ForwardRelocationRequest {
+	f := &ForwardRelocationRequest{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeForwardRelocationRequest, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			f.IMSI = i
+		case ie.FullyQualifiedTEID:
+			switch i.Instance() {
+			case 0:
+				f.SenderFTEID = i
+			case 1:
+				f.SGWS11S4FTEID = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.PDNConnection:
+			f.PDNConnections = append(f.PDNConnections, i)
+		case ie.FullyQualifiedDomainName:
+			switch i.Instance() {
+			case 0:
+				f.SGWNodeName = i
+			case 1:
+				f.SGSNNodeName = i
+			case 2:
+				f.MMENodeName = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.MMContextEPSSecurityContextQuadrupletsAndQuintuplets,
+			ie.MMContextGSMKeyAndTriplets, ie.MMContextGSMKeyUsedCipherAndQuintuplets,
+			ie.MMContextUMTSKeyAndQuintuplets, ie.MMContextUMTSKeyQuadrupletsAndQuintuplets,
+			ie.MMContextUMTSKeyUsedCipherAndQuintuplets:
+			f.MMContext = i
+		case ie.Indication:
+			f.IndicationFlags = i
+		case ie.FContainer:
+			switch i.Instance() {
+			case 0:
+				f.EUTRANTransparentContainer = i
+			case 1:
+				f.UTRANTransparentContainer = i
+			case 2:
+				f.BSSContainer = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.TargetIdentification:
+			f.TargetIdentification = i
+		case ie.IPAddress:
+			switch i.Instance() {
+			case 0:
+				f.HRPDAccessNodeS101IPAddress = i
+			case 1:
+				f.OnexIWSS102IPAddress = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.FCause:
+			switch i.Instance() {
+			case 0:
+				f.S1APCause = i
+			case 1:
+				f.RANAPCause = i
+			case 2:
+				f.BSSGPCause = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.SourceIdentification:
+			f.SourceIdentification = i
+		case ie.PLMNID:
+			f.SelectedPLMNID = i
+		case ie.Recovery:
+			f.Recovery = i
+		case ie.TraceInformation:
+			f.TraceInformation = i
+		case ie.RFSPIndex:
+			switch i.Instance() {
+			case 0:
+				f.SubscribedRFSPIndex = i
+			case 1:
+				f.RFSPIndexInUse = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.CSGID:
+			f.CSGID = i
+		case ie.CSGMembershipIndication:
+			f.CSGMembershipIndication = i
+		case ie.UETimeZone:
+			f.UETimeZone = i
+		case ie.ServingNetwork:
+			f.ServingNetwork = i
+		case ie.LocalDistinguishedName:
+			f.MMESGSNLDN = i
+		case ie.AdditionalMMContextForSRVCC:
+			f.AdditionalMMContextForSRVCC = i
+		case ie.AdditionalFlagsForSRVCC:
+			f.AdditionalFlagsForSRVCC = i
+		case ie.STNSR:
+			f.STNSR = i
+		case ie.MSISDN:
+			switch i.Instance() {
+			case 0:
+				f.CMSISDN = i
+			case 1:
+				f.MSISDN = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.MDTConfiguration:
+			f.MDTConfiguration = i
+		case ie.UserCSGInformation:
+			f.UCI = i
+		case ie.MonitoringEventInformation:
+			f.MonitoringEventInformation = i
+		case ie.IntegerNumber:
+			switch i.Instance() {
+			case 0:
+				f.UEUsageType = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.SCEFPDNConnection:
+			f.SCEFPDNConnections = append(f.SCEFPDNConnections, i)
+		case ie.PortNumber:
+			f.SourceUDPPortNumber = i
+		case ie.ServingPLMNRateControl:
+			f.ServingPLMNRateControl = i
+		case ie.ExtendedTraceInformation:
+			f.ExtendedTraceInformation = i
+		case ie.AdditionalRRMPolicyIndex:
+			switch i.Instance() {
+			case 0:
+				f.SubscribedAdditionalRRMPolicyIndex = i
+			case 1:
+				f.AdditionalRRMPolicyIndexInUse = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	f.SetLength()
+	return f
+}
+
+// Marshal serializes ForwardRelocationRequest into bytes.
+func (f *ForwardRelocationRequest) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes ForwardRelocationRequest into bytes.
+func (f *ForwardRelocationRequest) MarshalTo(b []byte) error {
+	if f.Header.Payload != nil {
+		f.Header.Payload = nil
+	}
+	f.Header.Payload = make([]byte, f.MarshalLen()-f.Header.MarshalLen())
+
+	offset := 0
+	if ie := f.IMSI; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SenderFTEID; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	for _, ie := range f.PDNConnections {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SGWS11S4FTEID; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SGWNodeName; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MMContext; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.IndicationFlags; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.EUTRANTransparentContainer; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.UTRANTransparentContainer; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.BSSContainer; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.TargetIdentification; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.HRPDAccessNodeS101IPAddress; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.OnexIWSS102IPAddress; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.S1APCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.RANAPCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.BSSGPCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SourceIdentification; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SelectedPLMNID; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.Recovery; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.TraceInformation; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SubscribedRFSPIndex; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.RFSPIndexInUse; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.CSGID; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.CSGMembershipIndication; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.UETimeZone; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.ServingNetwork; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MMESGSNLDN; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.AdditionalMMContextForSRVCC; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.AdditionalFlagsForSRVCC; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.STNSR; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.CMSISDN; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MDTConfiguration; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SGSNNodeName; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MMENodeName; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.UCI; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MonitoringEventInformation; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.UEUsageType; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	for _, ie := range f.SCEFPDNConnections {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MSISDN; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SourceUDPPortNumber; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.ServingPLMNRateControl; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.ExtendedTraceInformation; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SubscribedAdditionalRRMPolicyIndex; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.AdditionalRRMPolicyIndexInUse; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	f.Header.SetLength()
+	return f.Header.MarshalTo(b)
+}
+
+// ParseForwardRelocationRequest decodes given bytes as ForwardRelocationRequest.
+func ParseForwardRelocationRequest(b []byte) (*ForwardRelocationRequest, error) {
+	f := &ForwardRelocationRequest{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes as ForwardRelocationRequest.
+func (f *ForwardRelocationRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	f.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(f.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(f.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			f.IMSI = i
+		case ie.FullyQualifiedTEID:
+			switch i.Instance() {
+			case 0:
+				f.SenderFTEID = i
+			case 1:
+				f.SGWS11S4FTEID = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.PDNConnection:
+			f.PDNConnections = append(f.PDNConnections, i)
+		case ie.FullyQualifiedDomainName:
+			switch i.Instance() {
+			case 0:
+				f.SGWNodeName = i
+			case 1:
+				f.SGSNNodeName = i
+			case 2:
+				f.MMENodeName = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.MMContextEPSSecurityContextQuadrupletsAndQuintuplets,
+			ie.MMContextGSMKeyAndTriplets, ie.MMContextGSMKeyUsedCipherAndQuintuplets,
+			ie.MMContextUMTSKeyAndQuintuplets, ie.MMContextUMTSKeyQuadrupletsAndQuintuplets,
+			ie.MMContextUMTSKeyUsedCipherAndQuintuplets:
+			f.MMContext = i
+		case ie.Indication:
+			f.IndicationFlags = i
+		case ie.FContainer:
+			switch i.Instance() {
+			case 0:
+				f.EUTRANTransparentContainer = i
+			case 1:
+				f.UTRANTransparentContainer = i
+			case 2:
+				f.BSSContainer = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.TargetIdentification:
+			f.TargetIdentification = i
+		case ie.IPAddress:
+			switch i.Instance() {
+			case 0:
+				f.HRPDAccessNodeS101IPAddress = i
+			case 1:
+				f.OnexIWSS102IPAddress = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.FCause:
+			switch i.Instance() {
+			case 0:
+				f.S1APCause = i
+			case 1:
+				f.RANAPCause = i
+			case 2:
+				f.BSSGPCause = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.SourceIdentification:
+			f.SourceIdentification = i
+		case ie.PLMNID:
+			f.SelectedPLMNID = i
+		case ie.Recovery:
+			f.Recovery = i
+		case ie.TraceInformation:
+			f.TraceInformation = i
+		case ie.RFSPIndex:
+			switch i.Instance() {
+			case 0:
+				f.SubscribedRFSPIndex = i
+			case 1:
+				f.RFSPIndexInUse = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.CSGID:
+			f.CSGID = i
+		case ie.CSGMembershipIndication:
+			f.CSGMembershipIndication = i
+		case ie.UETimeZone:
+			f.UETimeZone = i
+		case ie.ServingNetwork:
+			f.ServingNetwork = i
+		case ie.LocalDistinguishedName:
+			f.MMESGSNLDN = i
+		case ie.AdditionalMMContextForSRVCC:
+			f.AdditionalMMContextForSRVCC = i
+		case ie.AdditionalFlagsForSRVCC:
+			f.AdditionalFlagsForSRVCC = i
+		case ie.STNSR:
+			f.STNSR = i
+		case ie.MSISDN:
+			switch i.Instance() {
+			case 0:
+				f.CMSISDN = i
+			case 1:
+				f.MSISDN = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.MDTConfiguration:
+			f.MDTConfiguration = i
+		case ie.UserCSGInformation:
+			f.UCI = i
+		case ie.MonitoringEventInformation:
+			f.MonitoringEventInformation = i
+		case ie.IntegerNumber:
+			switch i.Instance() {
+			case 0:
+				f.UEUsageType = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.SCEFPDNConnection:
+			f.SCEFPDNConnections = append(f.SCEFPDNConnections, i)
+		case ie.PortNumber:
+			f.SourceUDPPortNumber = i
+		case ie.ServingPLMNRateControl:
+			f.ServingPLMNRateControl = i
+		case ie.ExtendedTraceInformation:
+			f.ExtendedTraceInformation = i
+		case ie.AdditionalRRMPolicyIndex:
+			switch i.Instance() {
+			case 0:
+				f.SubscribedAdditionalRRMPolicyIndex = i
+			case 1:
+				f.AdditionalRRMPolicyIndexInUse = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (f *ForwardRelocationRequest) MarshalLen() int {
+	l := f.Header.MarshalLen() - len(f.Header.Payload)
+
+	if ie := f.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SenderFTEID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	for _, ie := range f.PDNConnections {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	if ie := f.SGWS11S4FTEID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SGWNodeName; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.MMContext; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.IndicationFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.EUTRANTransparentContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.UTRANTransparentContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.BSSContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.TargetIdentification; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.HRPDAccessNodeS101IPAddress; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.OnexIWSS102IPAddress; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.S1APCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.RANAPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.BSSGPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SourceIdentification; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SelectedPLMNID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.Recovery; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.TraceInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SubscribedRFSPIndex; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.RFSPIndexInUse; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.CSGID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.CSGMembershipIndication; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.UETimeZone; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.ServingNetwork; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.MMESGSNLDN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.AdditionalMMContextForSRVCC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.AdditionalFlagsForSRVCC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.STNSR; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.CMSISDN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.MDTConfiguration; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SGSNNodeName; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.MMENodeName; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.UCI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.MonitoringEventInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.UEUsageType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	for _, ie := range f.SCEFPDNConnections {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	if ie := f.MSISDN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SourceUDPPortNumber; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.ServingPLMNRateControl; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.ExtendedTraceInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SubscribedAdditionalRRMPolicyIndex; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.AdditionalRRMPolicyIndexInUse; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (f *ForwardRelocationRequest) SetLength() {
+	f.Header.Length = uint16(f.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (f *ForwardRelocationRequest) MessageTypeName() string {
+	return "Forward Relocation Request"
+}
+
+// TEID returns the TEID in uint32.
+func (f *ForwardRelocationRequest) TEID() uint32 {
+	return f.Header.teid()
+}
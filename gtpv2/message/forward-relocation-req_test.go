@@ -0,0 +1,78 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestForwardRelocationRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewForwardRelocationRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123451234567890"),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS10MMEGTPC, 0xffffffff, "1.1.1.1", ""),
+				ie.NewPDNConnection(ie.NewEPSBearerID(5)),
+				ie.NewPDNConnection(ie.NewEPSBearerID(6)),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS11S4SGWGTPC, 0xffffffff, "1.1.1.2", "").WithInstance(1),
+				ie.NewFullyQualifiedDomainName("sgw.example"),
+				ie.New(ie.FContainer, 0, []byte{0x03, 0xde, 0xad, 0xbe, 0xef}),
+				ie.New(ie.TargetIdentification, 0, []byte{0x01, 0x21, 0xf3, 0x54, 0x00, 0x01, 0x01, 0x02, 0x03, 0x04}),
+				ie.New(ie.FCause, 0, []byte{0x00, 0x02}),
+				ie.NewRecovery(1),
+				ie.NewServingNetwork("123", "45"),
+				ie.NewSCEFPDNConnection(ie.NewEPSBearerID(7)),
+				ie.NewSCEFPDNConnection(ie.NewEPSBearerID(8)),
+				ie.NewMSISDN("123450123456789").WithInstance(1),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x85, 0x00, 0x97, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// IMSI
+				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// SenderFTEID
+				0x57, 0x00, 0x09, 0x00, 0x8c, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+				// PDNConnections
+				0x6d, 0x00, 0x05, 0x00, 0x49, 0x00, 0x01, 0x00, 0x05,
+				0x6d, 0x00, 0x05, 0x00, 0x49, 0x00, 0x01, 0x00, 0x06,
+				// SGWS11S4FTEID
+				0x57, 0x00, 0x09, 0x01, 0x8b, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x02,
+				// SGWNodeName
+				0x88, 0x00, 0x0c, 0x00, 0x03, 0x73, 0x67, 0x77, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+				// EUTRANTransparentContainer
+				0x76, 0x00, 0x05, 0x00, 0x03, 0xde, 0xad, 0xbe, 0xef,
+				// TargetIdentification
+				0x79, 0x00, 0x0a, 0x00, 0x01, 0x21, 0xf3, 0x54, 0x00, 0x01, 0x01, 0x02, 0x03, 0x04,
+				// S1APCause
+				0x77, 0x00, 0x02, 0x00, 0x00, 0x02,
+				// Recovery
+				0x03, 0x00, 0x01, 0x00, 0x01,
+				// ServingNetwork
+				0x53, 0x00, 0x03, 0x00, 0x21, 0xf3, 0x54,
+				// SCEFPDNConnections
+				0xc3, 0x00, 0x05, 0x00, 0x49, 0x00, 0x01, 0x00, 0x07,
+				0xc3, 0x00, 0x05, 0x00, 0x49, 0x00, 0x01, 0x00, 0x08,
+				// MSISDN
+				0x4c, 0x00, 0x08, 0x01, 0x21, 0x43, 0x05, 0x21, 0x43, 0x65, 0x87, 0xf9,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseForwardRelocationRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
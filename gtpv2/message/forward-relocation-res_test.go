@@ -0,0 +1,79 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestForwardRelocationResponse(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewForwardRelocationResponse(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS10MMEGTPC, 0xffffffff, "1.1.1.2", ""),
+				ie.NewBearerContext(ie.NewEPSBearerID(5), ie.NewFullyQualifiedTEID(gtpv2.IFTypeeNodeBGTPUForDL, 0x11111111, "1.1.1.3", "").WithInstance(1)),
+				ie.NewBearerContext(ie.NewEPSBearerID(6), ie.NewFullyQualifiedTEID(gtpv2.IFTypeeNodeBGTPUForDL, 0x22222222, "1.1.1.3", "").WithInstance(1)),
+				ie.New(ie.FContainer, 0, []byte{0x03, 0xde, 0xad, 0xbe, 0xef}),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x86, 0x00, 0x50, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// Cause
+				0x02, 0x00, 0x02, 0x00, 0x10, 0x00,
+				// SenderFTEID
+				0x57, 0x00, 0x09, 0x00, 0x8c, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x02,
+				// ListOfSetupBearers
+				0x5d, 0x00, 0x12, 0x00, 0x49, 0x00, 0x01, 0x00, 0x05, 0x57, 0x00, 0x09, 0x01, 0x93, 0x11, 0x11,
+				0x11, 0x11, 0x01, 0x01, 0x01, 0x03,
+				0x5d, 0x00, 0x12, 0x00, 0x49, 0x00, 0x01, 0x00, 0x06, 0x57, 0x00, 0x09, 0x01, 0x93, 0x22, 0x22,
+				0x22, 0x22, 0x01, 0x01, 0x01, 0x03,
+				// EUTRANTransparentContainer
+				0x76, 0x00, 0x05, 0x00, 0x03, 0xde, 0xad, 0xbe, 0xef,
+			},
+		}, {
+			Description: "RABsAndPFCs",
+			Structured: message.NewForwardRelocationResponse(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS10MMEGTPC, 0xffffffff, "1.1.1.2", ""),
+				ie.NewBearerContext(ie.NewEPSBearerID(5)).WithInstance(1),
+				ie.NewBearerContext(ie.NewEPSBearerID(6)).WithInstance(1),
+				ie.NewBearerContext(ie.NewEPSBearerID(7)).WithInstance(2),
+				ie.NewBearerContext(ie.NewEPSBearerID(8)).WithInstance(2),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x86, 0x00, 0x3f, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// Cause
+				0x02, 0x00, 0x02, 0x00, 0x10, 0x00,
+				// SenderFTEID
+				0x57, 0x00, 0x09, 0x00, 0x8c, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x02,
+				// ListOfSetupRABs
+				0x5d, 0x00, 0x05, 0x01, 0x49, 0x00, 0x01, 0x00, 0x05,
+				0x5d, 0x00, 0x05, 0x01, 0x49, 0x00, 0x01, 0x00, 0x06,
+				// ListOfSetupPFCs
+				0x5d, 0x00, 0x05, 0x02, 0x49, 0x00, 0x01, 0x00, 0x07,
+				0x5d, 0x00, 0x05, 0x02, 0x49, 0x00, 0x01, 0x00, 0x08,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseForwardRelocationResponse(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
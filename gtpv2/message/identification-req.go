@@ -0,0 +1,276 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// IdentificationRequest is a IdentificationRequest Header and its IEs above.
+type IdentificationRequest struct {
+	*Header
+	GUTI                         *ie.IE
+	RAI                          *ie.IE
+	PTMSI                        *ie.IE
+	PTMSISignature               *ie.IE
+	CompleteAttachRequestMessage *ie.IE
+	AddressForControlPlane       *ie.IE
+	UDPSourcePortNumber          *ie.IE
+	HopCounter                   *ie.IE
+	TargetPLMNID                 *ie.IE
+	PrivateExtension             *ie.IE
+	AdditionalIEs                []*ie.IE
+}
+
+// NewIdentificationRequest creates a new IdentificationRequest.
+func NewIdentificationRequest(teid, seq uint32, ies ...*ie.IE) *IdentificationRequest {
+	m := &IdentificationRequest{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeIdentificationRequest, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.GUTI:
+			m.GUTI = i
+		case ie.UserLocationInformation:
+			m.RAI = i
+		case ie.PacketTMSI:
+			m.PTMSI = i
+		case ie.PTMSISignature:
+			m.PTMSISignature = i
+		case ie.CompleteRequestMessage:
+			m.CompleteAttachRequestMessage = i
+		case ie.IPAddress:
+			m.AddressForControlPlane = i
+		case ie.PortNumber:
+			m.UDPSourcePortNumber = i
+		case ie.HopCounter:
+			m.HopCounter = i
+		case ie.ServingNetwork:
+			m.TargetPLMNID = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal serializes IdentificationRequest into bytes.
+func (m *IdentificationRequest) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes IdentificationRequest into bytes.
+func (m *IdentificationRequest) MarshalTo(b []byte) error {
+	if m.Header.Payload != nil {
+		m.Header.Payload = nil
+	}
+	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
+
+	offset := 0
+	if ie := m.GUTI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.RAI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PTMSI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PTMSISignature; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.CompleteAttachRequestMessage; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.AddressForControlPlane; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.UDPSourcePortNumber; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.HopCounter; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.TargetPLMNID; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	m.Header.SetLength()
+	return m.Header.MarshalTo(b)
+}
+
+// ParseIdentificationRequest decodes given bytes as IdentificationRequest.
+func ParseIdentificationRequest(b []byte) (*IdentificationRequest, error) {
+	m := &IdentificationRequest{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes given bytes as IdentificationRequest.
+func (m *IdentificationRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.GUTI:
+			m.GUTI = i
+		case ie.UserLocationInformation:
+			m.RAI = i
+		case ie.PacketTMSI:
+			m.PTMSI = i
+		case ie.PTMSISignature:
+			m.PTMSISignature = i
+		case ie.CompleteRequestMessage:
+			m.CompleteAttachRequestMessage = i
+		case ie.IPAddress:
+			m.AddressForControlPlane = i
+		case ie.PortNumber:
+			m.UDPSourcePortNumber = i
+		case ie.HopCounter:
+			m.HopCounter = i
+		case ie.ServingNetwork:
+			m.TargetPLMNID = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (m *IdentificationRequest) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if ie := m.GUTI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.RAI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PTMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PTMSISignature; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.CompleteAttachRequestMessage; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.AddressForControlPlane; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.UDPSourcePortNumber; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.HopCounter; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.TargetPLMNID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *IdentificationRequest) SetLength() {
+	m.Header.Length = uint16(m.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *IdentificationRequest) MessageTypeName() string {
+	return "Identification Request"
+}
+
+// TEID returns the TEID in uint32.
+func (m *IdentificationRequest) TEID() uint32 {
+	return m.Header.teid()
+}
@@ -0,0 +1,268 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// IdentificationResponse is a IdentificationResponse Header and its IEs above.
+type IdentificationResponse struct {
+	*Header
+	Cause                               *ie.IE
+	IMSI                                *ie.IE
+	MMContext                           *ie.IE
+	TraceInformation                    *ie.IE
+	UEUsageType                         *ie.IE
+	MonitoringEventInformation          *ie.IE
+	MonitoringEventExtensionInformation *ie.IE
+	ExtendedTraceInformation            *ie.IE
+	PrivateExtension                    *ie.IE
+	AdditionalIEs                       []*ie.IE
+}
+
+// NewIdentificationResponse creates a new IdentificationResponse.
+func NewIdentificationResponse(teid, seq uint32, ies ...*ie.IE) *IdentificationResponse {
+	m := &IdentificationResponse{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeIdentificationResponse, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			m.Cause = i
+		case ie.IMSI:
+			m.IMSI = i
+		case ie.MMContextEPSSecurityContextQuadrupletsAndQuintuplets,
+			ie.MMContextGSMKeyAndTriplets, ie.MMContextGSMKeyUsedCipherAndQuintuplets,
+			ie.MMContextUMTSKeyAndQuintuplets, ie.MMContextUMTSKeyQuadrupletsAndQuintuplets,
+			ie.MMContextUMTSKeyUsedCipherAndQuintuplets:
+			m.MMContext = i
+		case ie.TraceInformation:
+			m.TraceInformation = i
+		case ie.IntegerNumber:
+			m.UEUsageType = i
+		case ie.MonitoringEventInformation:
+			m.MonitoringEventInformation = i
+		case ie.MonitoringEventExtensionInformation:
+			m.MonitoringEventExtensionInformation = i
+		case ie.ExtendedTraceInformation:
+			m.ExtendedTraceInformation = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	m.SetLength()
+	return m
+}
+
+// Marshal serializes IdentificationResponse into bytes.
+func (m *IdentificationResponse) Marshal() ([]byte, error) {
+	b := make([]byte, m.MarshalLen())
+	if err := m.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes IdentificationResponse into bytes.
+func (m *IdentificationResponse) MarshalTo(b []byte) error {
+	if m.Header.Payload != nil {
+		m.Header.Payload = nil
+	}
+	m.Header.Payload = make([]byte, m.MarshalLen()-m.Header.MarshalLen())
+
+	offset := 0
+	if ie := m.Cause; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.IMSI; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MMContext; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.TraceInformation; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.UEUsageType; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MonitoringEventInformation; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.MonitoringEventExtensionInformation; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.ExtendedTraceInformation; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(m.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(m.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	m.Header.SetLength()
+	return m.Header.MarshalTo(b)
+}
+
+// ParseIdentificationResponse decodes given bytes as IdentificationResponse.
+func ParseIdentificationResponse(b []byte) (*IdentificationResponse, error) {
+	m := &IdentificationResponse{}
+	if err := m.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UnmarshalBinary decodes given bytes as IdentificationResponse.
+func (m *IdentificationResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	m.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(m.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(m.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			m.Cause = i
+		case ie.IMSI:
+			m.IMSI = i
+		case ie.MMContextEPSSecurityContextQuadrupletsAndQuintuplets,
+			ie.MMContextGSMKeyAndTriplets, ie.MMContextGSMKeyUsedCipherAndQuintuplets,
+			ie.MMContextUMTSKeyAndQuintuplets, ie.MMContextUMTSKeyQuadrupletsAndQuintuplets,
+			ie.MMContextUMTSKeyUsedCipherAndQuintuplets:
+			m.MMContext = i
+		case ie.TraceInformation:
+			m.TraceInformation = i
+		case ie.IntegerNumber:
+			m.UEUsageType = i
+		case ie.MonitoringEventInformation:
+			m.MonitoringEventInformation = i
+		case ie.MonitoringEventExtensionInformation:
+			m.MonitoringEventExtensionInformation = i
+		case ie.ExtendedTraceInformation:
+			m.ExtendedTraceInformation = i
+		case ie.PrivateExtension:
+			m.PrivateExtension = i
+		default:
+			m.AdditionalIEs = append(m.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (m *IdentificationResponse) MarshalLen() int {
+	l := m.Header.MarshalLen() - len(m.Header.Payload)
+
+	if ie := m.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MMContext; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.TraceInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.UEUsageType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MonitoringEventInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.MonitoringEventExtensionInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.ExtendedTraceInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := m.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range m.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (m *IdentificationResponse) SetLength() {
+	m.Header.Length = uint16(m.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (m *IdentificationResponse) MessageTypeName() string {
+	return "Identification Response"
+}
+
+// TEID returns the TEID in uint32.
+func (m *IdentificationResponse) TEID() uint32 {
+	return m.Header.teid()
+}
@@ -0,0 +1,68 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestMBMSSessionStartRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewMBMSSessionStartRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeSmMBMSGWGTPC, 0xffffffff, "1.1.1.1", ""),
+				ie.NewTMGI(0x123456, "123", "45"),
+				ie.NewMBMSSessionDuration(1*time.Hour),
+				ie.NewMBMSServiceArea(1),
+				ie.NewMBMSSessionIdentifier(1),
+				ie.NewMBMSFlowIdentifier(0x1234),
+				ie.NewBearerQoS(1, 2, 1, 0xff, 0x1111111111, 0x2222222222, 0x1111111111, 0x2222222222),
+				ie.NewMBMSIPMulticastDistribution(0x11111111, "239.0.0.1", "10.0.0.1", 0),
+				ie.NewMBMSFlags(0, 1),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0xe7, 0x00, 0x6a, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// SenderFTEIDC
+				0x57, 0x00, 0x09, 0x00, 0x98, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+				// TMGI
+				0x9e, 0x00, 0x06, 0x00, 0x12, 0x34, 0x56, 0x21, 0xf3, 0x54,
+				// MBMSSessionDuration
+				0x8a, 0x00, 0x03, 0x00, 0x07, 0x08, 0x00,
+				// MBMSServiceArea
+				0x8b, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01,
+				// MBMSSessionIdentifier
+				0x8c, 0x00, 0x01, 0x00, 0x01,
+				// MBMSFlowIdentifier
+				0x8d, 0x00, 0x02, 0x00, 0x12, 0x34,
+				// QoSProfile
+				0x50, 0x00, 0x16, 0x00, 0x49, 0xff, 0x11, 0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22, 0x22,
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22, 0x22,
+				// MBMSIPMulticastDistribution
+				0x8e, 0x00, 0x0f, 0x00, 0x11, 0x11, 0x11, 0x11, 0x04, 0xef, 0x00, 0x00, 0x01, 0x04, 0x0a, 0x00,
+				0x00, 0x01, 0x00,
+				// MBMSFlags
+				0xab, 0x00, 0x01, 0x00, 0x01,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseMBMSSessionStartRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
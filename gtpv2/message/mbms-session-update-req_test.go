@@ -0,0 +1,50 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestMBMSSessionUpdateRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewMBMSSessionUpdateRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewMBMSServiceArea(1, 2),
+				ie.NewTMGI(0x123456, "123", "45"),
+				ie.NewMBMSSessionDuration(2*time.Hour),
+				ie.NewMBMSFlowIdentifier(0x1234),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0xe9, 0x00, 0x28, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// MBMSServiceArea
+				0x8b, 0x00, 0x05, 0x00, 0x01, 0x00, 0x01, 0x00, 0x02,
+				// TMGI
+				0x9e, 0x00, 0x06, 0x00, 0x12, 0x34, 0x56, 0x21, 0xf3, 0x54,
+				// MBMSSessionDuration
+				0x8a, 0x00, 0x03, 0x00, 0x0e, 0x10, 0x00,
+				// MBMSFlowIdentifier
+				0x8d, 0x00, 0x02, 0x00, 0x12, 0x34,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseMBMSSessionUpdateRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -385,6 +385,106 @@ func Parse(b []byte) (Message, error) {
 		m = &DownlinkDataNotificationAcknowledge{}
 	case MsgTypeDownlinkDataNotificationFailureIndication:
 		m = &DownlinkDataNotificationFailureIndication{}
+	case MsgTypeForwardRelocationRequest:
+		m = &ForwardRelocationRequest{}
+	case MsgTypeForwardRelocationResponse:
+		m = &ForwardRelocationResponse{}
+	case MsgTypeForwardRelocationCompleteNotification:
+		m = &ForwardRelocationCompleteNotification{}
+	case MsgTypeForwardRelocationCompleteAcknowledge:
+		m = &ForwardRelocationCompleteAcknowledge{}
+	case MsgTypeForwardAccessContextNotification:
+		m = &ForwardAccessContextNotification{}
+	case MsgTypeForwardAccessContextAcknowledge:
+		m = &ForwardAccessContextAcknowledge{}
+	case MsgTypeRelocationCancelRequest:
+		m = &RelocationCancelRequest{}
+	case MsgTypeRelocationCancelResponse:
+		m = &RelocationCancelResponse{}
+	case MsgTypeCreateIndirectDataForwardingTunnelRequest:
+		m = &CreateIndirectDataForwardingTunnelRequest{}
+	case MsgTypeCreateIndirectDataForwardingTunnelResponse:
+		m = &CreateIndirectDataForwardingTunnelResponse{}
+	case MsgTypeDeleteIndirectDataForwardingTunnelRequest:
+		m = &DeleteIndirectDataForwardingTunnelRequest{}
+	case MsgTypeDeleteIndirectDataForwardingTunnelResponse:
+		m = &DeleteIndirectDataForwardingTunnelResponse{}
+	case MsgTypeBearerResourceCommand:
+		m = &BearerResourceCommand{}
+	case MsgTypeBearerResourceFailureIndication:
+		m = &BearerResourceFailureIndication{}
+	case MsgTypeSuspendNotification:
+		m = &SuspendNotification{}
+	case MsgTypeSuspendAcknowledge:
+		m = &SuspendAcknowledge{}
+	case MsgTypeResumeNotification:
+		m = &ResumeNotification{}
+	case MsgTypeResumeAcknowledge:
+		m = &ResumeAcknowledge{}
+	case MsgTypeChangeNotificationRequest:
+		m = &ChangeNotificationRequest{}
+	case MsgTypeChangeNotificationResponse:
+		m = &ChangeNotificationResponse{}
+	case MsgTypeIdentificationRequest:
+		m = &IdentificationRequest{}
+	case MsgTypeIdentificationResponse:
+		m = &IdentificationResponse{}
+	case MsgTypeMBMSSessionStartRequest:
+		m = &MBMSSessionStartRequest{}
+	case MsgTypeMBMSSessionStartResponse:
+		m = &MBMSSessionStartResponse{}
+	case MsgTypeMBMSSessionUpdateRequest:
+		m = &MBMSSessionUpdateRequest{}
+	case MsgTypeMBMSSessionUpdateResponse:
+		m = &MBMSSessionUpdateResponse{}
+	case MsgTypeMBMSSessionStopRequest:
+		m = &MBMSSessionStopRequest{}
+	case MsgTypeMBMSSessionStopResponse:
+		m = &MBMSSessionStopResponse{}
+	case MsgTypeSRVCCPsToCsRequest:
+		m = &SRVCCPsToCsRequest{}
+	case MsgTypeSRVCCPsToCsResponse:
+		m = &SRVCCPsToCsResponse{}
+	case MsgTypeSRVCCPsToCsCompleteNotification:
+		m = &SRVCCPsToCsCompleteNotification{}
+	case MsgTypeSRVCCPsToCsCompleteAcknowledge:
+		m = &SRVCCPsToCsCompleteAcknowledge{}
+	case MsgTypeSRVCCPsToCsCancelNotification:
+		m = &SRVCCPsToCsCancelNotification{}
+	case MsgTypeSRVCCPsToCsCancelAcknowledge:
+		m = &SRVCCPsToCsCancelAcknowledge{}
+	case MsgTypeSRVCCCsToPsRequest:
+		m = &SRVCCCsToPsRequest{}
+	case MsgTypeSRVCCCsToPsResponse:
+		m = &SRVCCCsToPsResponse{}
+	case MsgTypeSRVCCCsToPsCompleteNotification:
+		m = &SRVCCCsToPsCompleteNotification{}
+	case MsgTypeSRVCCCsToPsCompleteAcknowledge:
+		m = &SRVCCCsToPsCompleteAcknowledge{}
+	case MsgTypeSRVCCCsToPsCancelNotification:
+		m = &SRVCCCsToPsCancelNotification{}
+	case MsgTypeSRVCCCsToPsCancelAcknowledge:
+		m = &SRVCCCsToPsCancelAcknowledge{}
+	case MsgTypeTraceSessionActivation:
+		m = &TraceSessionActivation{}
+	case MsgTypeTraceSessionDeactivation:
+		m = &TraceSessionDeactivation{}
+	case MsgTypeAlertMMENotification:
+		m = &AlertMMENotification{}
+	case MsgTypeAlertMMEAcknowledge:
+		m = &AlertMMEAcknowledge{}
+	case MsgTypeUEActivityNotification:
+		m = &UEActivityNotification{}
+	case MsgTypeUEActivityAcknowledge:
+		m = &UEActivityAcknowledge{}
+	case MsgTypeCreateForwardingTunnelRequest:
+		m = &CreateForwardingTunnelRequest{}
+	case MsgTypeCreateForwardingTunnelResponse:
+		m = &CreateForwardingTunnelResponse{}
+	case MsgTypePGWDownlinkTriggeringNotification:
+		m = &PGWDownlinkTriggeringNotification{}
+	case MsgTypePGWDownlinkTriggeringAcknowledge:
+		m = &PGWDownlinkTriggeringAcknowledge{}
 	default:
 		m = &Generic{}
 	}
@@ -429,7 +529,7 @@ func prettifyFields(fields []*field) []string {
 		// TODO: do this recursively?
 		v, ok := field.maybeIE.(*ie.IE)
 		if !ok {
-			// only for AdditionalIEs field
+			// for the fields that can have multiple IEs, such as AdditionalIEs
 			if ies, ok := field.maybeIE.([]*ie.IE); ok {
 				vals := make([]string, len(ies))
 				for i, val := range ies {
@@ -0,0 +1,192 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// PGWDownlinkTriggeringAcknowledge is a PGWDownlinkTriggeringAcknowledge Header and its IEs above.
+type PGWDownlinkTriggeringAcknowledge struct {
+	*Header
+	Cause               *ie.IE
+	IMSI                *ie.IE
+	MMES4SGSNIdentifier *ie.IE
+	PrivateExtension    *ie.IE
+	AdditionalIEs       []*ie.IE
+}
+
+// NewPGWDownlinkTriggeringAcknowledge creates a new PGWDownlinkTriggeringAcknowledge.
+func NewPGWDownlinkTriggeringAcknowledge(teid, seq uint32, ies ...*ie.IE) *PGWDownlinkTriggeringAcknowledge {
+	p := &PGWDownlinkTriggeringAcknowledge{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypePGWDownlinkTriggeringAcknowledge, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			p.Cause = i
+		case ie.IMSI:
+			p.IMSI = i
+		case ie.IPAddress:
+			p.MMES4SGSNIdentifier = i
+		case ie.PrivateExtension:
+			p.PrivateExtension = i
+		default:
+			p.AdditionalIEs = append(p.AdditionalIEs, i)
+		}
+	}
+
+	p.SetLength()
+	return p
+}
+
+// Marshal serializes PGWDownlinkTriggeringAcknowledge into bytes.
+func (p *PGWDownlinkTriggeringAcknowledge) Marshal() ([]byte, error) {
+	b := make([]byte, p.MarshalLen())
+	if err := p.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes PGWDownlinkTriggeringAcknowledge into bytes.
+func (p *PGWDownlinkTriggeringAcknowledge) MarshalTo(b []byte) error {
+	if p.Header.Payload != nil {
+		p.Header.Payload = nil
+	}
+	p.Header.Payload = make([]byte, p.MarshalLen()-p.Header.MarshalLen())
+
+	offset := 0
+	if ie := p.Cause; ie != nil {
+		if err := ie.MarshalTo(p.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := p.IMSI; ie != nil {
+		if err := ie.MarshalTo(p.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := p.MMES4SGSNIdentifier; ie != nil {
+		if err := ie.MarshalTo(p.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := p.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(p.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range p.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(p.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	p.Header.SetLength()
+	return p.Header.MarshalTo(b)
+}
+
+// ParsePGWDownlinkTriggeringAcknowledge decodes given bytes as PGWDownlinkTriggeringAcknowledge.
+func ParsePGWDownlinkTriggeringAcknowledge(b []byte) (*PGWDownlinkTriggeringAcknowledge, error) {
+	p := &PGWDownlinkTriggeringAcknowledge{}
+	if err := p.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// UnmarshalBinary decodes given bytes as PGWDownlinkTriggeringAcknowledge.
+func (p *PGWDownlinkTriggeringAcknowledge) UnmarshalBinary(b []byte) error {
+	var err error
+	p.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(p.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(p.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			p.Cause = i
+		case ie.IMSI:
+			p.IMSI = i
+		case ie.IPAddress:
+			p.MMES4SGSNIdentifier = i
+		case ie.PrivateExtension:
+			p.PrivateExtension = i
+		default:
+			p.AdditionalIEs = append(p.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (p *PGWDownlinkTriggeringAcknowledge) MarshalLen() int {
+	l := p.Header.MarshalLen() - len(p.Header.Payload)
+
+	if ie := p.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := p.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := p.MMES4SGSNIdentifier; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := p.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range p.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (p *PGWDownlinkTriggeringAcknowledge) SetLength() {
+	p.Header.Length = uint16(p.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (p *PGWDownlinkTriggeringAcknowledge) MessageTypeName() string {
+	return "PGW Downlink Triggering Acknowledge"
+}
+
+// TEID returns the TEID in uint32.
+func (p *PGWDownlinkTriggeringAcknowledge) TEID() uint32 {
+	return p.Header.teid()
+}
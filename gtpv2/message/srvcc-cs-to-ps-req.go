@@ -0,0 +1,262 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// SRVCCCsToPsRequest is a SRVCCCsToPsRequest Header and its IEs above.
+type SRVCCCsToPsRequest struct {
+	*Header
+	IMSI                               *ie.IE
+	MEI                                *ie.IE
+	SenderFTEIDC                       *ie.IE
+	MMContextForCSToPSSRVCC            *ie.IE
+	SourceToTargetTransparentContainer *ie.IE
+	TargetIdentification               *ie.IE
+	SourceSAI                          *ie.IE
+	SvFlags                            *ie.IE
+	PrivateExtension                   *ie.IE
+	AdditionalIEs                      []*ie.IE
+}
+
+// NewSRVCCCsToPsRequest creates a new SRVCCCsToPsRequest.
+func NewSRVCCCsToPsRequest(teid, seq uint32, ies ...*ie.IE) *SRVCCCsToPsRequest {
+	s := &SRVCCCsToPsRequest{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeSRVCCCsToPsRequest, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			s.IMSI = i
+		case ie.MobileEquipmentIdentity:
+			s.MEI = i
+		case ie.FullyQualifiedTEID:
+			s.SenderFTEIDC = i
+		case ie.MMContextForCSToPSSRVCC:
+			s.MMContextForCSToPSSRVCC = i
+		case ie.SourceToTargetTransparentContainer:
+			s.SourceToTargetTransparentContainer = i
+		case ie.TargetIdentification:
+			s.TargetIdentification = i
+		case ie.ServiceAreaIdentifier:
+			s.SourceSAI = i
+		case ie.SvFlags:
+			s.SvFlags = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	s.SetLength()
+	return s
+}
+
+// Marshal serializes SRVCCCsToPsRequest into bytes.
+func (s *SRVCCCsToPsRequest) Marshal() ([]byte, error) {
+	b := make([]byte, s.MarshalLen())
+	if err := s.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes SRVCCCsToPsRequest into bytes.
+func (s *SRVCCCsToPsRequest) MarshalTo(b []byte) error {
+	if s.Header.Payload != nil {
+		s.Header.Payload = nil
+	}
+	s.Header.Payload = make([]byte, s.MarshalLen()-s.Header.MarshalLen())
+
+	offset := 0
+	if ie := s.IMSI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.MEI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SenderFTEIDC; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.MMContextForCSToPSSRVCC; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SourceToTargetTransparentContainer; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.TargetIdentification; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SourceSAI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SvFlags; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(s.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	s.Header.SetLength()
+	return s.Header.MarshalTo(b)
+}
+
+// ParseSRVCCCsToPsRequest decodes given bytes as SRVCCCsToPsRequest.
+func ParseSRVCCCsToPsRequest(b []byte) (*SRVCCCsToPsRequest, error) {
+	s := &SRVCCCsToPsRequest{}
+	if err := s.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UnmarshalBinary decodes given bytes as SRVCCCsToPsRequest.
+func (s *SRVCCCsToPsRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	s.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(s.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(s.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			s.IMSI = i
+		case ie.MobileEquipmentIdentity:
+			s.MEI = i
+		case ie.FullyQualifiedTEID:
+			s.SenderFTEIDC = i
+		case ie.MMContextForCSToPSSRVCC:
+			s.MMContextForCSToPSSRVCC = i
+		case ie.SourceToTargetTransparentContainer:
+			s.SourceToTargetTransparentContainer = i
+		case ie.TargetIdentification:
+			s.TargetIdentification = i
+		case ie.ServiceAreaIdentifier:
+			s.SourceSAI = i
+		case ie.SvFlags:
+			s.SvFlags = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (s *SRVCCCsToPsRequest) MarshalLen() int {
+	l := s.Header.MarshalLen() - len(s.Header.Payload)
+
+	if ie := s.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.MEI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SenderFTEIDC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.MMContextForCSToPSSRVCC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SourceToTargetTransparentContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.TargetIdentification; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SourceSAI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SvFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (s *SRVCCCsToPsRequest) SetLength() {
+	s.Header.Length = uint16(s.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (s *SRVCCCsToPsRequest) MessageTypeName() string {
+	return "SRVCC CS to PS Request"
+}
+
+// TEID returns the TEID in uint32.
+func (s *SRVCCCsToPsRequest) TEID() uint32 {
+	return s.Header.teid()
+}
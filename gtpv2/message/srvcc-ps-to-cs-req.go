@@ -0,0 +1,360 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// SRVCCPsToCsRequest is a SRVCCPsToCsRequest Header and its IEs above.
+type SRVCCPsToCsRequest struct {
+	*Header
+	IMSI                               *ie.IE
+	MEI                                *ie.IE
+	SenderFTEIDC                       *ie.IE
+	MMContextForEUTRANSRVCC            *ie.IE
+	MMContextForUTRANSRVCC             *ie.IE
+	SRVCCCause                         *ie.IE
+	SourceToTargetTransparentContainer *ie.IE
+	TargetRNCID                        *ie.IE
+	TargetGlobalCellID                 *ie.IE
+	CMSISDN                            *ie.IE
+	STNSR                              *ie.IE
+	SvFlags                            *ie.IE
+	SourceSAI                          *ie.IE
+	AdditionalMMContextForSRVCC        *ie.IE
+	AdditionalFlagsForSRVCC            *ie.IE
+	PrivateExtension                   *ie.IE
+	AdditionalIEs                      []*ie.IE
+}
+
+// NewSRVCCPsToCsRequest creates a new SRVCCPsToCsRequest.
+func NewSRVCCPsToCsRequest(teid, seq uint32, ies ...*ie.IE) *SRVCCPsToCsRequest {
+	s := &SRVCCPsToCsRequest{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeSRVCCPsToCsRequest, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			s.IMSI = i
+		case ie.MobileEquipmentIdentity:
+			s.MEI = i
+		case ie.FullyQualifiedTEID:
+			s.SenderFTEIDC = i
+		case ie.MMContextForEUTRANSRVCC:
+			s.MMContextForEUTRANSRVCC = i
+		case ie.MMContextForUTRANSRVCC:
+			s.MMContextForUTRANSRVCC = i
+		case ie.SRVCCCause:
+			s.SRVCCCause = i
+		case ie.SourceToTargetTransparentContainer:
+			s.SourceToTargetTransparentContainer = i
+		case ie.TargetRNCID:
+			s.TargetRNCID = i
+		case ie.TargetGlobalCellID:
+			s.TargetGlobalCellID = i
+		case ie.MSISDN:
+			s.CMSISDN = i
+		case ie.STNSR:
+			s.STNSR = i
+		case ie.SvFlags:
+			s.SvFlags = i
+		case ie.ServiceAreaIdentifier:
+			s.SourceSAI = i
+		case ie.AdditionalMMContextForSRVCC:
+			s.AdditionalMMContextForSRVCC = i
+		case ie.AdditionalFlagsForSRVCC:
+			s.AdditionalFlagsForSRVCC = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	s.SetLength()
+	return s
+}
+
+// Marshal serializes SRVCCPsToCsRequest into bytes.
+func (s *SRVCCPsToCsRequest) Marshal() ([]byte, error) {
+	b := make([]byte, s.MarshalLen())
+	if err := s.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes SRVCCPsToCsRequest into bytes.
+func (s *SRVCCPsToCsRequest) MarshalTo(b []byte) error {
+	if s.Header.Payload != nil {
+		s.Header.Payload = nil
+	}
+	s.Header.Payload = make([]byte, s.MarshalLen()-s.Header.MarshalLen())
+
+	offset := 0
+	if ie := s.IMSI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.MEI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SenderFTEIDC; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.MMContextForEUTRANSRVCC; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.MMContextForUTRANSRVCC; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SRVCCCause; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SourceToTargetTransparentContainer; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.TargetRNCID; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.TargetGlobalCellID; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.CMSISDN; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.STNSR; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SvFlags; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SourceSAI; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.AdditionalMMContextForSRVCC; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.AdditionalFlagsForSRVCC; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(s.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	s.Header.SetLength()
+	return s.Header.MarshalTo(b)
+}
+
+// ParseSRVCCPsToCsRequest decodes given bytes as SRVCCPsToCsRequest.
+func ParseSRVCCPsToCsRequest(b []byte) (*SRVCCPsToCsRequest, error) {
+	s := &SRVCCPsToCsRequest{}
+	if err := s.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UnmarshalBinary decodes given bytes as SRVCCPsToCsRequest.
+func (s *SRVCCPsToCsRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	s.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(s.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(s.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			s.IMSI = i
+		case ie.MobileEquipmentIdentity:
+			s.MEI = i
+		case ie.FullyQualifiedTEID:
+			s.SenderFTEIDC = i
+		case ie.MMContextForEUTRANSRVCC:
+			s.MMContextForEUTRANSRVCC = i
+		case ie.MMContextForUTRANSRVCC:
+			s.MMContextForUTRANSRVCC = i
+		case ie.SRVCCCause:
+			s.SRVCCCause = i
+		case ie.SourceToTargetTransparentContainer:
+			s.SourceToTargetTransparentContainer = i
+		case ie.TargetRNCID:
+			s.TargetRNCID = i
+		case ie.TargetGlobalCellID:
+			s.TargetGlobalCellID = i
+		case ie.MSISDN:
+			s.CMSISDN = i
+		case ie.STNSR:
+			s.STNSR = i
+		case ie.SvFlags:
+			s.SvFlags = i
+		case ie.ServiceAreaIdentifier:
+			s.SourceSAI = i
+		case ie.AdditionalMMContextForSRVCC:
+			s.AdditionalMMContextForSRVCC = i
+		case ie.AdditionalFlagsForSRVCC:
+			s.AdditionalFlagsForSRVCC = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (s *SRVCCPsToCsRequest) MarshalLen() int {
+	l := s.Header.MarshalLen() - len(s.Header.Payload)
+
+	if ie := s.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.MEI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SenderFTEIDC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.MMContextForEUTRANSRVCC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.MMContextForUTRANSRVCC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SRVCCCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SourceToTargetTransparentContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.TargetRNCID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.TargetGlobalCellID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.CMSISDN; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.STNSR; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SvFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SourceSAI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.AdditionalMMContextForSRVCC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.AdditionalFlagsForSRVCC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (s *SRVCCPsToCsRequest) SetLength() {
+	s.Header.Length = uint16(s.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (s *SRVCCPsToCsRequest) MessageTypeName() string {
+	return "SRVCC PS to CS Request"
+}
+
+// TEID returns the TEID in uint32.
+func (s *SRVCCPsToCsRequest) TEID() uint32 {
+	return s.Header.teid()
+}
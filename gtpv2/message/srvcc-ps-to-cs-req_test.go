@@ -0,0 +1,68 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestSRVCCPsToCsRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewSRVCCPsToCsRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123451234567890"),
+				ie.NewMobileEquipmentIdentity("123450123456789"),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS11MMEGTPC, 0xffffffff, "1.1.1.1", ""),
+				ie.New(ie.SRVCCCause, 0x00, []byte{0x01}),
+				ie.New(ie.SourceToTargetTransparentContainer, 0x00, []byte{0x02, 0xde, 0xad}),
+				ie.NewMSISDN("819012345678"),
+				ie.NewSTNSR(0x91, "819012345678"),
+				ie.New(ie.SvFlags, 0x00, []byte{0x02}),
+				ie.NewAdditionalMMContextForSRVCC([]byte{0x57, 0x58, 0xa6}, []byte{0x60, 0x14}, []byte{0x04, 0x02, 0x60, 0x04}),
+				ie.NewAdditionalFlagsForSRVCC(0, 1),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x19, 0x00, 0x68, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// IMSI
+				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// MEI
+				0x4b, 0x00, 0x08, 0x00, 0x21, 0x43, 0x05, 0x21, 0x43, 0x65, 0x87, 0xf9,
+				// SenderFTEIDC
+				0x57, 0x00, 0x09, 0x00, 0x8a, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+				// SRVCCCause
+				0x38, 0x00, 0x01, 0x00, 0x01,
+				// SourceToTargetTransparentContainer
+				0x34, 0x00, 0x03, 0x00, 0x02, 0xde, 0xad,
+				// CMSISDN
+				0x4c, 0x00, 0x06, 0x00, 0x18, 0x09, 0x21, 0x43, 0x65, 0x87,
+				// STNSR
+				0x33, 0x00, 0x07, 0x00, 0x91, 0x18, 0x09, 0x21, 0x43, 0x65, 0x87,
+				// SvFlags
+				0x3c, 0x00, 0x01, 0x00, 0x02,
+				// AdditionalMMContextForSRVCC
+				0x9f, 0x00, 0x0c, 0x00, 0x03, 0x57, 0x58, 0xa6, 0x02, 0x60, 0x14, 0x04, 0x04, 0x02, 0x60, 0x04,
+				// AdditionalFlagsForSRVCC
+				0xa0, 0x00, 0x01, 0x00, 0x01,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseSRVCCPsToCsRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestSuspendNotification(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewSuspendNotification(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123451234567890"),
+				ie.NewUserLocationInformationLazy("123", "45", 0x1111, -1, -1, 0x22, -1, -1, -1, -1),
+				ie.NewEPSBearerID(5),
+				ie.NewPacketTMSI(0xdeadbeef),
+				ie.NewNodeType(gtpv2.NodeTypeSGSN),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS4SGSNGTPC, 0xffffffff, "1.1.1.1", ""),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0xa2, 0x00, 0x44, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// IMSI
+				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// RAI
+				0x56, 0x00, 0x0d, 0x00, 0x24, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x00, 0x22, 0x21, 0xf3, 0x54, 0x11,
+				0x11,
+				// LinkedEBI
+				0x49, 0x00, 0x01, 0x00, 0x05,
+				// PTMSI
+				0x6f, 0x00, 0x04, 0x00, 0xde, 0xad, 0xbe, 0xef,
+				// OriginatingNode
+				0x87, 0x00, 0x01, 0x00, 0x00,
+				// SenderFTEIDC
+				0x57, 0x00, 0x09, 0x00, 0x91, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseSuspendNotification(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
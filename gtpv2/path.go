@@ -0,0 +1,329 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+// PathEventType is the type of PathEvent.
+type PathEventType uint8
+
+// PathEventType definitions.
+const (
+	_ PathEventType = iota
+	PathEventUp
+	PathEventDown
+	PathEventPeerRestarted
+)
+
+// String returns the name of PathEventType.
+func (t PathEventType) String() string {
+	switch t {
+	case PathEventUp:
+		return "Path Up"
+	case PathEventDown:
+		return "Path Down"
+	case PathEventPeerRestarted:
+		return "Peer Restarted"
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(t))
+	}
+}
+
+// PathEvent is an event on the path between Conn and a peer.
+type PathEvent struct {
+	Type PathEventType
+	Peer net.Addr
+
+	// RestartCounter is the latest Restart Counter advertised by the peer.
+	// This is valid only if HasRestartCounter is true.
+	RestartCounter    uint8
+	HasRestartCounter bool
+
+	// PurgedSessions is the Sessions removed from Conn due to the restart of the
+	// peer. This is set only with PathEventPeerRestarted, and only if the purge
+	// is enabled with EnableSessionPurge.
+	PurgedSessions []*Session
+}
+
+// PathEventHandlerFunc is a handler for PathEvent.
+type PathEventHandlerFunc func(c *Conn, ev *PathEvent)
+
+// path is the state of the path between Conn and a peer.
+type path struct {
+	mu                sync.Mutex
+	addr              net.Addr
+	isUp              bool
+	checking          bool
+	restartCounter    uint8
+	hasRestartCounter bool
+}
+
+// SetPathEventHandler sets the handler called when the state of the path to any
+// of the peers changes. See StartPathManagement for how the state is managed.
+func (c *Conn) SetPathEventHandler(fn PathEventHandlerFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pathEventHandler = fn
+}
+
+// EnableSessionPurge makes Conn remove all the Sessions tied to the peer when
+// it detects the peer has restarted. See TS23.007 for details.
+//
+// The removed Sessions are passed to the PathEventHandlerFunc with the event.
+func (c *Conn) EnableSessionPurge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessionPurgeEnabled = true
+}
+
+// DisableSessionPurge turns off the removal of Sessions on peer's restart,
+// which is disabled by default.
+func (c *Conn) DisableSessionPurge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessionPurgeEnabled = false
+}
+
+// StartPathManagement starts sending Echo Request periodically to every peer
+// known to Conn, i.e., the ones that Conn has sent initial messages to, the ones
+// that own the Sessions registered to Conn, and the ones added with AddPeer.
+// The peers that just send messages to Conn are not watched, not to keep sending
+// Echo Request to unsolicited or spoofed sources.
+//
+// A path is considered down when no Echo Response comes even after the
+// retransmissions, and considered up again when Echo Response comes. The Restart
+// Counter in the Recovery IE of Echo Request/Response from peer is compared with the
+// previous one to detect the restart of the peer. Each change is notified to the
+// handler set with SetPathEventHandler.
+//
+// It stops when ctx is done or Conn is closed.
+func (c *Conn) StartPathManagement(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.closed():
+				return
+			case <-ticker.C:
+			}
+
+			c.pathMap.rangeWithFunc(func(k, v interface{}) bool {
+				go c.checkPath(ctx, v.(*path))
+				return true
+			})
+		}
+	}()
+}
+
+// AddPeer adds the peer to the targets of the path management. Peers are added
+// automatically when Conn sends an initial message to them or a Session with them
+// is registered, so this is needed only to watch the other peers.
+func (c *Conn) AddPeer(peer net.Addr) {
+	c.learnPeer(peer)
+}
+
+// RemovePeer removes the peer from the targets of the path management, e.g.,
+// when all the Sessions with the peer are removed.
+func (c *Conn) RemovePeer(peer net.Addr) {
+	c.pathMap.delete(peer.String())
+}
+
+// IsPathUp reports whether the path to the peer is considered up.
+func (c *Conn) IsPathUp(peer net.Addr) bool {
+	p, ok := c.pathMap.load(peer.String())
+	if !ok {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.isUp
+}
+
+// PeerRestartCounter returns the latest Restart Counter advertised by the peer.
+// It returns false if no Restart Counter has been received from the peer.
+func (c *Conn) PeerRestartCounter(peer net.Addr) (uint8, bool) {
+	p, ok := c.pathMap.load(peer.String())
+	if !ok {
+		return 0, false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.restartCounter, p.hasRestartCounter
+}
+
+// RemoveSessionsByPeer removes all the Sessions whose peer is the one given,
+// and returns the removed ones.
+func (c *Conn) RemoveSessionsByPeer(peer net.Addr) []*Session {
+	peerStr := peer.String()
+
+	var removed []*Session
+	for _, sess := range c.Sessions() {
+		if sess.peerAddrString != peerStr {
+			continue
+		}
+		c.RemoveSession(sess)
+		removed = append(removed, sess)
+	}
+	return removed
+}
+
+func (c *Conn) learnPeer(peer net.Addr) *path {
+	if p, ok := c.pathMap.load(peer.String()); ok {
+		return p
+	}
+	p, _ := c.pathMap.loadOrStore(peer.String(), &path{addr: peer, isUp: true})
+	return p
+}
+
+func (c *Conn) checkPath(ctx context.Context, p *path) {
+	p.mu.Lock()
+	if p.checking {
+		p.mu.Unlock()
+		return
+	}
+	p.checking = true
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		p.checking = false
+		p.mu.Unlock()
+	}()
+
+	res, err := c.Request(ctx, p.addr, message.NewEchoRequest(0, ie.NewRecovery(c.RestartCounter)))
+	if err != nil {
+		var toErr *RequestTimeoutError
+		if errors.As(err, &toErr) {
+			c.setPathState(p, false)
+		}
+		return
+	}
+
+	c.setPathState(p, true)
+	if echo, ok := res.(*message.EchoResponse); ok {
+		c.handleRecovery(p.addr, echo.Recovery)
+	}
+}
+
+func (c *Conn) setPathState(p *path, isUp bool) {
+	p.mu.Lock()
+	if p.isUp == isUp {
+		p.mu.Unlock()
+		return
+	}
+	p.isUp = isUp
+	ev := &PathEvent{
+		Type:              PathEventDown,
+		Peer:              p.addr,
+		RestartCounter:    p.restartCounter,
+		HasRestartCounter: p.hasRestartCounter,
+	}
+	p.mu.Unlock()
+
+	if isUp {
+		ev.Type = PathEventUp
+	}
+	c.notifyPathEvent(ev)
+}
+
+// handleRecovery updates the Restart Counter of the peer with the value in Recovery IE
+// and checks if the peer has restarted. Nothing is done for the peers not known to Conn.
+func (c *Conn) handleRecovery(peer net.Addr, recovery *ie.IE) {
+	if recovery == nil {
+		return
+	}
+	p, ok := c.pathMap.load(peer.String())
+	if !ok {
+		return
+	}
+	counter, err := recovery.Recovery()
+	if err != nil {
+		logf("invalid Recovery IE from %s: %v", peer, err)
+		return
+	}
+
+	p.mu.Lock()
+	restarted := p.hasRestartCounter && p.restartCounter != counter
+	p.restartCounter = counter
+	p.hasRestartCounter = true
+	p.mu.Unlock()
+
+	if !restarted {
+		return
+	}
+
+	ev := &PathEvent{
+		Type:              PathEventPeerRestarted,
+		Peer:              peer,
+		RestartCounter:    counter,
+		HasRestartCounter: true,
+	}
+
+	c.mu.Lock()
+	purge := c.sessionPurgeEnabled
+	c.mu.Unlock()
+	if purge {
+		ev.PurgedSessions = c.RemoveSessionsByPeer(peer)
+	}
+
+	c.notifyPathEvent(ev)
+}
+
+func (c *Conn) notifyPathEvent(ev *PathEvent) {
+	c.mu.Lock()
+	fn := c.pathEventHandler
+	c.mu.Unlock()
+
+	if fn == nil {
+		logf("%s: %s", ev.Type, ev.Peer)
+		return
+	}
+	fn(c, ev)
+}
+
+type pathMap struct {
+	syncMap sync.Map
+}
+
+func newPathMap() *pathMap {
+	return &pathMap{}
+}
+
+func (m *pathMap) loadOrStore(peer string, p *path) (*path, bool) {
+	v, loaded := m.syncMap.LoadOrStore(peer, p)
+	return v.(*path), loaded
+}
+
+func (m *pathMap) load(peer string) (*path, bool) {
+	v, ok := m.syncMap.Load(peer)
+	if !ok {
+		return nil, false
+	}
+	return v.(*path), true
+}
+
+func (m *pathMap) delete(peer string) {
+	m.syncMap.Delete(peer)
+}
+
+func (m *pathMap) rangeWithFunc(fn func(peer, p interface{}) bool) {
+	m.syncMap.Range(fn)
+}
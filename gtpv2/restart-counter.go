@@ -0,0 +1,126 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// RestartCounterStore is a persistent storage of the Restart Counter, which is
+// used to keep the value in Recovery IE correct across the restarts of the node.
+type RestartCounterStore interface {
+	// Load returns the Restart Counter saved last time.
+	// It should return 0 without error if nothing has been saved yet.
+	Load() (uint8, error)
+	// Save saves the Restart Counter given.
+	Save(counter uint8) error
+}
+
+// FileRestartCounterStore is a RestartCounterStore that keeps the Restart Counter
+// in a file as a decimal number.
+type FileRestartCounterStore struct {
+	path string
+}
+
+// NewFileRestartCounterStore creates a new FileRestartCounterStore that uses the
+// file at path. The file is created when the counter is saved first time.
+func NewFileRestartCounterStore(path string) *FileRestartCounterStore {
+	return &FileRestartCounterStore{path: path}
+}
+
+// Load reads the Restart Counter from the file.
+func (s *FileRestartCounterStore) Load() (uint8, error) {
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	v, err := strconv.ParseUint(strings.TrimSpace(string(b)), 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Restart Counter in %s: %w", s.path, err)
+	}
+	return uint8(v), nil
+}
+
+// Save writes the Restart Counter to the file.
+//
+// The counter is written to a temporary file in the same directory first, and
+// then the file is renamed, so that the file is never left half-written.
+func (s *FileRestartCounterStore) Save(counter uint8) error {
+	f, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+
+	if _, err := f.WriteString(strconv.Itoa(int(counter)) + "\n"); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	if err := os.Rename(tmp, s.path); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// IncrementRestartCounter loads the Restart Counter from store, increments it and
+// saves the new value. This is expected to be called once on every start-up of the node.
+//
+// The counter wraps around to 0 after 255.
+func IncrementRestartCounter(store RestartCounterStore) (uint8, error) {
+	counter, err := store.Load()
+	if err != nil {
+		return 0, fmt.Errorf("failed to load Restart Counter: %w", err)
+	}
+
+	counter++
+	if err := store.Save(counter); err != nil {
+		return 0, fmt.Errorf("failed to save Restart Counter: %w", err)
+	}
+	return counter, nil
+}
+
+// NewConnWithRestartCounterStore creates a new Conn in the same way as NewConn, with
+// the Restart Counter incremented with the value in store.
+func NewConnWithRestartCounterStore(laddr net.Addr, localIfType uint8, store RestartCounterStore) (*Conn, error) {
+	counter, err := IncrementRestartCounter(store)
+	if err != nil {
+		return nil, err
+	}
+	return NewConn(laddr, localIfType, counter), nil
+}
+
+// DialWithRestartCounterStore opens a Conn in the same way as Dial, with the Restart
+// Counter incremented with the value in store.
+func DialWithRestartCounterStore(ctx context.Context, laddr, raddr net.Addr, localIfType uint8, store RestartCounterStore) (*Conn, error) {
+	counter, err := IncrementRestartCounter(store)
+	if err != nil {
+		return nil, err
+	}
+	return Dial(ctx, laddr, raddr, localIfType, counter)
+}
@@ -0,0 +1,60 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+)
+
+func TestFileRestartCounterStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "restart-counter")
+	store := gtpv2.NewFileRestartCounterStore(path)
+
+	counter, err := store.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counter != 0 {
+		t.Errorf("wrong initial Restart Counter. want %d, got: %d", 0, counter)
+	}
+
+	for want := uint8(1); want <= 2; want++ {
+		got, err := gtpv2.IncrementRestartCounter(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("wrong Restart Counter. want %d, got: %d", want, got)
+		}
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), "2\n"; got != want {
+		t.Errorf("wrong file content. want %q, got: %q", want, got)
+	}
+
+	conn, err := gtpv2.NewConnWithRestartCounterStore(dummyAddr, gtpv2.IFTypeS11MMEGTPC, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn.RestartCounter != 3 {
+		t.Errorf("wrong Restart Counter in Conn. want %d, got: %d", 3, conn.RestartCounter)
+	}
+
+	// wrap around.
+	if err := store.Save(255); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := gtpv2.IncrementRestartCounter(store); err != nil || got != 0 {
+		t.Errorf("wrong Restart Counter after wrap-around. want %d, got: %d, err: %v", 0, got, err)
+	}
+}
@@ -5,10 +5,12 @@
 package gtpv2
 
 import (
+	"bytes"
 	"net"
 	"sync"
 	"time"
 
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
 	"github.com/wmnsk/go-gtp/gtpv2/message"
 )
 
@@ -20,6 +22,73 @@ type Location struct {
 	ECI, MeNBI, EMeNBI     uint32
 }
 
+// userLocationInformation returns the UserLocationInformation IE that contains
+// the location information to be reported with the Change Reporting Action given.
+//
+// It returns nil if the action does not request any location information, or if
+// none of the information requested is set in Location.
+func (l *Location) userLocationInformation(action uint8) *ie.IE {
+	var cgisai, rai, tai, ecgi, enbid bool
+	switch action {
+	case ChangeReportingActionStartReportingCGISAI:
+		cgisai = true
+	case ChangeReportingActionStartReportingRAI:
+		rai = true
+	case ChangeReportingActionStartReportingTAI:
+		tai = true
+	case ChangeReportingActionStartReportingECGI:
+		ecgi = true
+	case ChangeReportingActionStartReportingCGISAIAndRAI:
+		cgisai, rai = true, true
+	case ChangeReportingActionStartReportingTAIAndECGI:
+		tai, ecgi = true, true
+	case ChangeReportingActionStartReportingTAIMacroENBIDAndExtendedMacroENBID:
+		tai, enbid = true, true
+	case ChangeReportingActionStartReportingMacroENBIDAndExtendedMacroENBID:
+		enbid = true
+	default:
+		return nil
+	}
+
+	// the information that is not set in Location is not reported.
+	var (
+		cgiIE    *ie.CGI
+		saiIE    *ie.SAI
+		raiIE    *ie.RAI
+		taiIE    *ie.TAI
+		ecgiIE   *ie.ECGI
+		menbiIE  *ie.MENBI
+		emenbiIE *ie.EMENBI
+	)
+	if cgisai && l.CI != 0 {
+		cgiIE = ie.NewCGI(l.MCC, l.MNC, l.LAC, l.CI)
+	}
+	if cgisai && l.SAI != 0 {
+		saiIE = ie.NewSAI(l.MCC, l.MNC, l.LAC, l.SAI)
+	}
+	if rai && l.RAI != 0 {
+		raiIE = ie.NewRAI(l.MCC, l.MNC, l.LAC, l.RAI)
+	}
+	if tai && l.TAI != 0 {
+		taiIE = ie.NewTAI(l.MCC, l.MNC, l.TAI)
+	}
+	if ecgi && l.ECI != 0 {
+		ecgiIE = ie.NewECGI(l.MCC, l.MNC, l.ECI)
+	}
+	// only the eNodeB ID in use is reported.
+	if enbid && l.MeNBI != 0 {
+		menbiIE = ie.NewMENBI(l.MCC, l.MNC, l.MeNBI)
+	}
+	if enbid && l.EMeNBI != 0 {
+		emenbiIE = ie.NewEMENBI(l.MCC, l.MNC, l.EMeNBI)
+	}
+
+	if cgiIE == nil && saiIE == nil && raiIE == nil && taiIE == nil && ecgiIE == nil && menbiIE == nil && emenbiIE == nil {
+		return nil
+	}
+	return ie.NewUserLocationInformationStruct(cgiIE, saiIE, raiIE, taiIE, ecgiIE, nil, menbiIE, emenbiIE)
+}
+
 // Subscriber is a subscriber that belongs to a GTPv2 session.
 type Subscriber struct {
 	IMSI, MSISDN, IMEI string
@@ -30,6 +99,12 @@ type Subscriber struct {
 type Session struct {
 	mu       sync.Mutex
 	isActive bool
+	// isSuspended is true while the UE is in CS domain by CS fallback or SRVCC,
+	// which is set by Suspend Notification and cleared by Resume Notification.
+	isSuspended bool
+	// changeReportingAction is the Change Reporting Action requested by P-GW,
+	// which determines the location changes to be notified by MME/SGSN.
+	changeReportingAction uint8
 	*teidMap
 	*bearerMap
 
@@ -94,6 +169,86 @@ func (s *Session) IsActive() bool {
 	return s.isActive
 }
 
+// Suspend marks a Session suspended.
+//
+// This is called by Conn on S-GW/P-GW when Suspend Notification is received for the
+// Session, before the HandlerFunc for the message. While the Session is suspended,
+// (*Conn).DownlinkDataNotification returns ErrSessionSuspended without sending it.
+func (s *Session) Suspend() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.isSuspended = true
+}
+
+// Resume marks a suspended Session not suspended.
+//
+// This is called by Conn on S-GW/P-GW when Resume Notification is received for the
+// Session, before the HandlerFunc for the message.
+func (s *Session) Resume() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.isSuspended = false
+}
+
+// IsSuspended reports whether a Session is suspended or not.
+func (s *Session) IsSuspended() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.isSuspended
+}
+
+// SetChangeReportingAction sets the Change Reporting Action requested by P-GW.
+//
+// This is expected to be called by MME/SGSN with the value of ChangeReportingAction IE
+// in Create Session Response, Modify Bearer Response, etc.
+func (s *Session) SetChangeReportingAction(action uint8) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.changeReportingAction = action
+}
+
+// ChangeReportingAction returns the Change Reporting Action requested by P-GW.
+func (s *Session) ChangeReportingAction() uint8 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.changeReportingAction
+}
+
+// UpdateLocation updates the Location of the Subscriber associated with Session.
+//
+// If the location information requested by Change Reporting Action has changed,
+// it returns the UserLocationInformation IE to be notified to P-GW. Otherwise, it
+// returns nil.
+func (s *Session) UpdateLocation(loc *Location) *ie.IE {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Subscriber == nil {
+		s.Subscriber = &Subscriber{}
+	}
+	old := s.Location
+	s.Location = loc
+
+	if loc == nil {
+		return nil
+	}
+	uli := loc.userLocationInformation(s.changeReportingAction)
+	if uli == nil {
+		return nil
+	}
+	if old != nil {
+		if prev := old.userLocationInformation(s.changeReportingAction); prev != nil && bytes.Equal(prev.Payload, uli.Payload) {
+			return nil
+		}
+	}
+	return uli
+}
+
 // PeerAddr returns the address of the peer node associated with Session.
 func (s *Session) PeerAddr() net.Addr {
 	return s.peerAddr
@@ -0,0 +1,464 @@
+// Copyright 2019-2021 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+// Default values of the timer and counter used for the reliable delivery of
+// signalling messages. See TS29.274 7.6 for details.
+const (
+	DefaultT3Response = 3 * time.Second
+	DefaultN3Requests = 3
+)
+
+// TimeoutHandlerFunc is a handler called when no triggered message is received
+// in response to the initial message sent with SendMessageTo, even after the
+// retransmissions.
+//
+// err is always *RequestTimeoutError.
+type TimeoutHandlerFunc func(c *Conn, peerAddr net.Addr, msg message.Message, err error)
+
+// triggeredMessageTypes is the list of the initial messages that expects the
+// triggered messages, with the types of messages acceptable as the response.
+var triggeredMessageTypes = map[uint8][]uint8{
+	message.MsgTypeEchoRequest:                     {message.MsgTypeEchoResponse},
+	message.MsgTypeDirectTransferRequest:           {message.MsgTypeDirectTransferResponse},
+	message.MsgTypeNotificationRequest:             {message.MsgTypeNotificationResponse},
+	message.MsgTypeSRVCCPsToCsRequest:              {message.MsgTypeSRVCCPsToCsResponse},
+	message.MsgTypeSRVCCPsToCsCompleteNotification: {message.MsgTypeSRVCCPsToCsCompleteAcknowledge},
+	message.MsgTypeSRVCCPsToCsCancelNotification:   {message.MsgTypeSRVCCPsToCsCancelAcknowledge},
+	message.MsgTypeSRVCCCsToPsRequest:              {message.MsgTypeSRVCCCsToPsResponse},
+	message.MsgTypeSRVCCCsToPsCompleteNotification: {message.MsgTypeSRVCCCsToPsCompleteAcknowledge},
+	message.MsgTypeSRVCCCsToPsCancelNotification:   {message.MsgTypeSRVCCCsToPsCancelAcknowledge},
+	message.MsgTypeCreateSessionRequest:            {message.MsgTypeCreateSessionResponse},
+	message.MsgTypeModifyBearerRequest:             {message.MsgTypeModifyBearerResponse},
+	message.MsgTypeDeleteSessionRequest:            {message.MsgTypeDeleteSessionResponse},
+	message.MsgTypeChangeNotificationRequest:       {message.MsgTypeChangeNotificationResponse},
+	message.MsgTypeRemoteUEReportNotification:      {message.MsgTypeRemoteUEReportAcknowledge},
+	message.MsgTypeModifyBearerCommand: {
+		message.MsgTypeModifyBearerFailureIndication, message.MsgTypeUpdateBearerRequest,
+	},
+	message.MsgTypeDeleteBearerCommand: {
+		message.MsgTypeDeleteBearerFailureIndication, message.MsgTypeDeleteBearerRequest,
+	},
+	message.MsgTypeBearerResourceCommand: {
+		message.MsgTypeBearerResourceFailureIndication, message.MsgTypeCreateBearerRequest,
+		message.MsgTypeUpdateBearerRequest, message.MsgTypeDeleteBearerRequest,
+	},
+	message.MsgTypeCreateBearerRequest:                       {message.MsgTypeCreateBearerResponse},
+	message.MsgTypeUpdateBearerRequest:                       {message.MsgTypeUpdateBearerResponse},
+	message.MsgTypeDeleteBearerRequest:                       {message.MsgTypeDeleteBearerResponse},
+	message.MsgTypeDeletePDNConnectionSetRequest:             {message.MsgTypeDeletePDNConnectionSetResponse},
+	message.MsgTypePGWDownlinkTriggeringNotification:         {message.MsgTypePGWDownlinkTriggeringAcknowledge},
+	message.MsgTypeIdentificationRequest:                     {message.MsgTypeIdentificationResponse},
+	message.MsgTypeContextRequest:                            {message.MsgTypeContextResponse},
+	message.MsgTypeForwardRelocationRequest:                  {message.MsgTypeForwardRelocationResponse},
+	message.MsgTypeForwardRelocationCompleteNotification:     {message.MsgTypeForwardRelocationCompleteAcknowledge},
+	message.MsgTypeForwardAccessContextNotification:          {message.MsgTypeForwardAccessContextAcknowledge},
+	message.MsgTypeRelocationCancelRequest:                   {message.MsgTypeRelocationCancelResponse},
+	message.MsgTypeDetachNotification:                        {message.MsgTypeDetachAcknowledge},
+	message.MsgTypeAlertMMENotification:                      {message.MsgTypeAlertMMEAcknowledge},
+	message.MsgTypeUEActivityNotification:                    {message.MsgTypeUEActivityAcknowledge},
+	message.MsgTypeUERegistrationQueryRequest:                {message.MsgTypeUERegistrationQueryResponse},
+	message.MsgTypeCreateForwardingTunnelRequest:             {message.MsgTypeCreateForwardingTunnelResponse},
+	message.MsgTypeSuspendNotification:                       {message.MsgTypeSuspendAcknowledge},
+	message.MsgTypeResumeNotification:                        {message.MsgTypeResumeAcknowledge},
+	message.MsgTypeCreateIndirectDataForwardingTunnelRequest: {message.MsgTypeCreateIndirectDataForwardingTunnelResponse},
+	message.MsgTypeDeleteIndirectDataForwardingTunnelRequest: {message.MsgTypeDeleteIndirectDataForwardingTunnelResponse},
+	message.MsgTypeReleaseAccessBearersRequest:               {message.MsgTypeReleaseAccessBearersResponse},
+	message.MsgTypeDownlinkDataNotification:                  {message.MsgTypeDownlinkDataNotificationAcknowledge},
+	message.MsgTypePGWRestartNotification:                    {message.MsgTypePGWRestartNotificationAcknowledge},
+	message.MsgTypeUpdatePDNConnectionSetRequest:             {message.MsgTypeUpdatePDNConnectionSetResponse},
+	message.MsgTypeModifyAccessBearersRequest:                {message.MsgTypeModifyAccessBearersResponse},
+	message.MsgTypeMBMSSessionStartRequest:                   {message.MsgTypeMBMSSessionStartResponse},
+	message.MsgTypeMBMSSessionUpdateRequest:                  {message.MsgTypeMBMSSessionUpdateResponse},
+	message.MsgTypeMBMSSessionStopRequest:                    {message.MsgTypeMBMSSessionStopResponse},
+}
+
+// expectsResponse reports whether the message of msgType is the initial message
+// that expects the triggered message to come.
+func expectsResponse(msgType uint8) bool {
+	_, ok := triggeredMessageTypes[msgType]
+	return ok
+}
+
+// isTriggeredBy reports whether the message of resType can be the triggered message
+// of the initial message of reqType.
+func isTriggeredBy(resType, reqType uint8) bool {
+	for _, t := range triggeredMessageTypes[reqType] {
+		if t == resType {
+			return true
+		}
+	}
+	return false
+}
+
+type transactionKey struct {
+	peer string
+	seq  uint32
+}
+
+// transaction is an initial message sent by Conn waiting for the triggered
+// message to come.
+type transaction struct {
+	raddr   net.Addr
+	msg     message.Message
+	payload []byte
+
+	// synchronous is true if the triggered message is returned to the caller of
+	// Request, instead of being passed to the HandlerFunc.
+	synchronous bool
+
+	resCh  chan message.Message
+	errCh  chan error
+	doneCh chan struct{}
+	once   sync.Once
+}
+
+func newTransaction(raddr net.Addr, msg message.Message, payload []byte) *transaction {
+	return &transaction{
+		raddr:   raddr,
+		msg:     msg,
+		payload: payload,
+		resCh:   make(chan message.Message, 1),
+		errCh:   make(chan error, 1),
+		doneCh:  make(chan struct{}),
+	}
+}
+
+func (t *transaction) key() transactionKey {
+	return transactionKey{peer: t.raddr.String(), seq: t.msg.Sequence()}
+}
+
+// finish stops the transaction. It returns false if it's already finished.
+func (t *transaction) finish() bool {
+	finished := false
+	t.once.Do(func() {
+		close(t.doneCh)
+		finished = true
+	})
+	return finished
+}
+
+func (t *transaction) done() <-chan struct{} {
+	return t.doneCh
+}
+
+// SetT3Response sets the T3-RESPONSE timer, which is the period of time to wait for
+// the triggered message before retransmitting the initial message.
+//
+// The default value is DefaultT3Response. The value is applied to the messages sent
+// after calling this.
+func (c *Conn) SetT3Response(t3 time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t3Response = t3
+}
+
+// SetN3Requests sets the N3-REQUESTS counter, which is the maximum number of
+// retransmissions of an initial message. Setting 0 disables the retransmission,
+// but the transaction still times out after T3-RESPONSE.
+//
+// The default value is DefaultN3Requests. The value is applied to the messages sent
+// after calling this.
+func (c *Conn) SetN3Requests(n3 int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.n3Requests = n3
+}
+
+// SetTimeoutHandler sets the handler called when the initial message sent with
+// SendMessageTo(or the methods using it, such as CreateSession) gets no response
+// even after the retransmissions.
+//
+// If no handler is set, the timeout is just logged.
+func (c *Conn) SetTimeoutHandler(fn TimeoutHandlerFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.timeoutHandler = fn
+}
+
+// PendingRequestCount returns the number of initial messages that are still waiting
+// for the triggered messages to come.
+func (c *Conn) PendingRequestCount() int {
+	var count int
+	c.transactionMap.rangeWithFunc(func(k, v interface{}) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// startTransaction registers the transaction and starts the retransmission timer.
+// This should be called before sending the message first time, so that the
+// response coming quickly can be matched.
+func (c *Conn) startTransaction(tx *transaction) {
+	c.mu.Lock()
+	t3, n3 := c.t3Response, c.n3Requests
+	c.mu.Unlock()
+
+	if old, loaded := c.transactionMap.swap(tx.key(), tx); loaded {
+		// the sequence number has wrapped around before the old one finishes.
+		old.finish()
+	}
+
+	go c.retransmit(tx, t3, n3)
+}
+
+// cancelTransaction stops and removes the transaction without notifying anything.
+func (c *Conn) cancelTransaction(tx *transaction) {
+	tx.finish()
+	c.transactionMap.deleteIfSame(tx.key(), tx)
+}
+
+func (c *Conn) retransmit(tx *transaction, t3 time.Duration, n3 int) {
+	timer := time.NewTimer(t3)
+	defer timer.Stop()
+
+	for sent := 0; ; sent++ {
+		select {
+		case <-tx.done():
+			return
+		case <-c.closed():
+			c.cancelTransaction(tx)
+			return
+		case <-timer.C:
+		}
+
+		if sent >= n3 {
+			break
+		}
+
+		if _, err := c.WriteTo(tx.payload, tx.raddr); err != nil {
+			logf("failed to retransmit %s to %s: %v", tx.msg.MessageTypeName(), tx.raddr, err)
+		}
+		timer.Reset(t3)
+	}
+
+	if !tx.finish() {
+		return
+	}
+	c.transactionMap.deleteIfSame(tx.key(), tx)
+
+	err := &RequestTimeoutError{
+		MsgType: tx.msg.MessageTypeName(),
+		Seq:     tx.msg.Sequence(),
+		Peer:    tx.raddr.String(),
+	}
+	tx.errCh <- err
+	if tx.synchronous {
+		return
+	}
+
+	c.mu.Lock()
+	fn := c.timeoutHandler
+	c.mu.Unlock()
+	if fn == nil {
+		logf("%v", err)
+		return
+	}
+	fn(c, tx.raddr, tx.msg, err)
+}
+
+// resolveTransaction finishes the transaction that the msg from senderAddr is
+// the triggered message for. It returns the transaction found, or nil if the msg
+// is not for any of the outstanding transactions.
+func (c *Conn) resolveTransaction(senderAddr net.Addr, msg message.Message) *transaction {
+	key := transactionKey{peer: senderAddr.String(), seq: msg.Sequence()}
+	tx, ok := c.transactionMap.load(key)
+	if !ok {
+		return nil
+	}
+	if !isTriggeredBy(msg.MessageType(), tx.msg.MessageType()) {
+		return nil
+	}
+	if !tx.finish() {
+		return nil
+	}
+	c.transactionMap.deleteIfSame(key, tx)
+
+	tx.resCh <- msg
+	return tx
+}
+
+type transactionMap struct {
+	mu sync.Mutex
+	m  map[transactionKey]*transaction
+}
+
+func newTransactionMap() *transactionMap {
+	return &transactionMap{m: map[transactionKey]*transaction{}}
+}
+
+func (t *transactionMap) swap(key transactionKey, tx *transaction) (*transaction, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	old, ok := t.m[key]
+	t.m[key] = tx
+	return old, ok
+}
+
+func (t *transactionMap) load(key transactionKey) (*transaction, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tx, ok := t.m[key]
+	return tx, ok
+}
+
+func (t *transactionMap) deleteIfSame(key transactionKey, tx *transaction) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if cur, ok := t.m[key]; ok && cur == tx {
+		delete(t.m, key)
+	}
+}
+
+func (t *transactionMap) rangeWithFunc(fn func(key, tx interface{}) bool) {
+	t.mu.Lock()
+	txs := make(map[transactionKey]*transaction, len(t.m))
+	for k, v := range t.m {
+		txs[k] = v
+	}
+	t.mu.Unlock()
+
+	for k, v := range txs {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// cachedResponse is a triggered message sent in response to the initial message
+// received, kept to be replayed when the initial message is retransmitted by peer.
+//
+// payload is nil while the initial message is being handled.
+type cachedResponse struct {
+	mu      sync.Mutex
+	payload []byte
+}
+
+func (r *cachedResponse) load() []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.payload
+}
+
+func (r *cachedResponse) store(payload []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.payload = payload
+}
+
+// responseCacheTTL returns how long the triggered messages are kept in the cache.
+// The duration is long enough for the peer using the same T3/N3 values to give up
+// retransmitting the initial message.
+func (c *Conn) responseCacheTTL() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t3Response * time.Duration(c.n3Requests+1)
+}
+
+// checkDuplicate reports whether the initial message from senderAddr has already
+// been received. If it is a duplicate, the cached triggered message is sent again
+// if available. Otherwise the message is marked as received for a while.
+func (c *Conn) checkDuplicate(senderAddr net.Addr, msg message.Message) (bool, error) {
+	if !expectsResponse(msg.MessageType()) {
+		return false, nil
+	}
+
+	key := transactionKey{peer: senderAddr.String(), seq: msg.Sequence()}
+	res, loaded := c.responseCache.loadOrStore(key, &cachedResponse{})
+	if !loaded {
+		time.AfterFunc(c.responseCacheTTL(), func() {
+			c.responseCache.deleteIfSame(key, res)
+		})
+		return false, nil
+	}
+
+	// the response is not sent yet; just discard it as the handler is working on it.
+	payload := res.load()
+	if payload == nil {
+		return true, nil
+	}
+
+	if _, err := c.WriteTo(payload, senderAddr); err != nil {
+		return true, err
+	}
+	return true, nil
+}
+
+// forgetRequest removes the initial message from the cache to let it be handled
+// again when retransmitted, if no response has been sent for it.
+func (c *Conn) forgetRequest(senderAddr net.Addr, msg message.Message) {
+	key := transactionKey{peer: senderAddr.String(), seq: msg.Sequence()}
+	res, ok := c.responseCache.load(key)
+	if !ok || res.load() != nil {
+		return
+	}
+	c.responseCache.deleteIfSame(key, res)
+}
+
+// cacheResponse stores the triggered message sent in response to the initial message.
+func (c *Conn) cacheResponse(raddr net.Addr, received message.Message, payload []byte) {
+	if !expectsResponse(received.MessageType()) {
+		return
+	}
+
+	key := transactionKey{peer: raddr.String(), seq: received.Sequence()}
+	res, loaded := c.responseCache.loadOrStore(key, &cachedResponse{payload: payload})
+	if !loaded {
+		time.AfterFunc(c.responseCacheTTL(), func() {
+			c.responseCache.deleteIfSame(key, res)
+		})
+		return
+	}
+	res.store(payload)
+}
+
+type responseCache struct {
+	mu sync.Mutex
+	m  map[transactionKey]*cachedResponse
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{m: map[transactionKey]*cachedResponse{}}
+}
+
+func (r *responseCache) loadOrStore(key transactionKey, res *cachedResponse) (*cachedResponse, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cur, ok := r.m[key]; ok {
+		return cur, true
+	}
+	r.m[key] = res
+	return res, false
+}
+
+func (r *responseCache) load(key transactionKey) (*cachedResponse, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res, ok := r.m[key]
+	return res, ok
+}
+
+func (r *responseCache) deleteIfSame(key transactionKey, res *cachedResponse) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if cur, ok := r.m[key]; ok && cur == res {
+		delete(r.m, key)
+	}
+}